@@ -0,0 +1,24 @@
+data "cala_account_set_balance" "customer_liabilities" {
+  account_set_id = "8f7b2c6d-1e4a-4c3b-9d5f-0a6e2b7c8d91"
+  currency       = "USD"
+}
+
+data "cala_account_set_balance" "omnibus_assets" {
+  account_set_id = "2b4e6a8c-0d1f-4a3b-8c5d-7e9f1a2b3c4d"
+  currency       = "USD"
+}
+
+check "liabilities_backed_by_assets" {
+  assert {
+    condition     = data.cala_account_set_balance.customer_liabilities.settled.normal_balance == data.cala_account_set_balance.omnibus_assets.settled.normal_balance
+    error_message = "Customer liabilities do not match the omnibus asset accounts."
+  }
+}
+
+# Change of the customer liabilities over January.
+data "cala_account_set_balance" "customer_liabilities_january" {
+  account_set_id = "8f7b2c6d-1e4a-4c3b-9d5f-0a6e2b7c8d91"
+  currency       = "USD"
+  from           = "2024-01-01T00:00:00Z"
+  until          = "2024-02-01T00:00:00Z"
+}
@@ -0,0 +1,9 @@
+data "cala_account_set_members" "assets" {
+  account_set_id = "8f7b2c6d-1e4a-4c3b-9d5f-0a6e2b7c8d91"
+  recursive      = true
+  max_depth      = 5
+}
+
+output "asset_account_codes" {
+  value = [for account in data.cala_account_set_members.assets.accounts : account.code]
+}
@@ -0,0 +1,16 @@
+data "cala_accounts" "liabilities" {
+  code_prefix         = "LIABILITY."
+  status              = "ACTIVE"
+  normal_balance_type = "CREDIT"
+
+  metadata = {
+    team = "treasury"
+  }
+}
+
+resource "cala_account_set_member_account" "liabilities" {
+  for_each = { for account in data.cala_accounts.liabilities.accounts : account.code => account }
+
+  account_set_id    = "8f7b2c6d-1e4a-4c3b-9d5f-0a6e2b7c8d91"
+  member_account_id = each.value.id
+}
@@ -0,0 +1,5 @@
+data "cala_server" "this" {}
+
+output "cala_server_version" {
+  value = data.cala_server.this.version
+}
@@ -0,0 +1,12 @@
+data "cala_trial_balance" "usd" {
+  journal_id  = "4e2f1a3b-6c5d-4e7f-8a9b-0c1d2e3f4a5b"
+  currency    = "USD"
+  concurrency = 16
+}
+
+check "books_balance" {
+  assert {
+    condition     = data.cala_trial_balance.usd.balanced
+    error_message = "Debits (${data.cala_trial_balance.usd.total_debits}) and credits (${data.cala_trial_balance.usd.total_credits}) differ."
+  }
+}
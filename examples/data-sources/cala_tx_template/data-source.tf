@@ -0,0 +1,12 @@
+data "cala_tx_template" "deposit" {
+  code = "DEPOSIT"
+
+  lifecycle {
+    postcondition {
+      condition = contains([
+        for param in self.params : param.type if param.name == "amount"
+      ], "DECIMAL")
+      error_message = "The DEPOSIT tx template must declare a DECIMAL amount param."
+    }
+  }
+}
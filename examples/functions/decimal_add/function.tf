@@ -0,0 +1,16 @@
+data "cala_account_set_balance" "hot_wallets" {
+  account_set_id = "8f7b2c6d-1e4a-4c3b-9d5f-0a6e2b7c8d91"
+  currency       = "BTC"
+}
+
+data "cala_account_set_balance" "cold_wallets" {
+  account_set_id = "2b4e6a8c-0d1f-4a3b-8c5d-7e9f1a2b3c4d"
+  currency       = "BTC"
+}
+
+output "total_btc" {
+  value = provider::cala::decimal_add(
+    data.cala_account_set_balance.hot_wallets.settled.normal_balance,
+    data.cala_account_set_balance.cold_wallets.settled.normal_balance,
+  )
+}
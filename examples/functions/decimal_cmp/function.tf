@@ -0,0 +1,19 @@
+data "cala_account_set_balance" "customer_liabilities" {
+  account_set_id = "8f7b2c6d-1e4a-4c3b-9d5f-0a6e2b7c8d91"
+  currency       = "BTC"
+}
+
+data "cala_account_set_balance" "omnibus_assets" {
+  account_set_id = "2b4e6a8c-0d1f-4a3b-8c5d-7e9f1a2b3c4d"
+  currency       = "BTC"
+}
+
+check "assets_cover_liabilities" {
+  assert {
+    condition = provider::cala::decimal_cmp(
+      data.cala_account_set_balance.omnibus_assets.settled.normal_balance,
+      data.cala_account_set_balance.customer_liabilities.settled.normal_balance,
+    ) >= 0
+    error_message = "Omnibus assets do not cover customer liabilities."
+  }
+}
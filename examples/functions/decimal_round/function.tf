@@ -0,0 +1,4 @@
+output "rounded" {
+  # "12.35"
+  value = provider::cala::decimal_round("12.345", "USD")
+}
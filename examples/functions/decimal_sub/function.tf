@@ -0,0 +1,4 @@
+output "fee_adjusted" {
+  # "0.00099"
+  value = provider::cala::decimal_sub("0.00100000", "0.00001")
+}
@@ -0,0 +1,4 @@
+output "formatted" {
+  # "1,234.50 USD"
+  value = provider::cala::money_format("1234.5", "USD")
+}
@@ -0,0 +1,21 @@
+locals {
+  deposit = provider::cala::tx_template_evaluate(cala_tx_template.deposit, {
+    sender    = "00000000-0000-0000-0000-000000000001"
+    recipient = "00000000-0000-0000-0000-000000000002"
+    amount    = "100.50"
+  }, plantimestamp())
+}
+
+check "deposit_balances" {
+  assert {
+    condition     = local.deposit.balanced
+    error_message = "The DEPOSIT tx template does not balance: ${jsonencode(local.deposit.balances)}"
+  }
+}
+
+output "deposit_entries" {
+  value = [
+    for entry in local.deposit.entries :
+    "${entry.direction} ${entry.units} ${entry.currency} on ${entry.account_id} (${entry.layer})"
+  ]
+}
@@ -0,0 +1,16 @@
+locals {
+  # Generated once, e.g. with `uuidgen`, and shared with application code.
+  id_namespace = "6f1c2a9e-3b7d-4c58-9e0a-1d2b3c4d5e6f"
+}
+
+resource "cala_journal" "general" {
+  id   = provider::cala::uuid_from_code(local.id_namespace, "JOURNAL.GENERAL")
+  name = "General"
+}
+
+resource "cala_account" "bank" {
+  id                  = provider::cala::uuid_from_code(local.id_namespace, "BANK.DEPOSITS")
+  name                = "Bank cash"
+  code                = "BANK.DEPOSITS"
+  normal_balance_type = "DEBIT"
+}
@@ -0,0 +1,8 @@
+list "cala_account" "liabilities" {
+  provider = cala
+
+  config {
+    code_prefix = "LIABILITIES."
+    status      = "ACTIVE"
+  }
+}
@@ -0,0 +1,3 @@
+list "cala_outbox_import_job" "all" {
+  provider = cala
+}
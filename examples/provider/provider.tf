@@ -1 +1,18 @@
 provider "cala" {}
+
+# Aliased providers can carry the defaults of their cala environment.
+provider "cala" {
+  alias    = "eu"
+  endpoint = "https://cala.eu.example.com/graphql"
+
+  default_journal_id          = "9a4bd5ba-0f3c-4d7a-8d2b-0bd4e0c3c1f0"
+  default_currency            = "EUR"
+  default_normal_balance_type = "DEBIT"
+}
+
+# Plans against production are run with read_only, which never changes the ledger.
+provider "cala" {
+  alias     = "production"
+  endpoint  = "https://cala.example.com/graphql"
+  read_only = true
+}
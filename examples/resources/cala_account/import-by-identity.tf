@@ -0,0 +1,6 @@
+import {
+  to = cala_account.cash
+  identity = {
+    id = "00000000-0000-0000-0000-000000000001"
+  }
+}
@@ -0,0 +1,2 @@
+# Accounts are imported by ID.
+terraform import cala_account.cash 00000000-0000-0000-0000-000000000001
@@ -1,3 +1,10 @@
+resource "random_uuid" "journal_id" {}
+
+resource "cala_journal" "journal" {
+  id   = random_uuid.journal_id.result
+  name = "Default"
+}
+
 resource "random_uuid" "alice_account_id" {}
 
 resource "cala_account" "alice" {
@@ -12,4 +19,13 @@ resource "cala_account" "bank" {
   name                = "Bank cash"
   code                = "BANK.DEPOSITS.${random_uuid.bank.result}"
   normal_balance_type = "DEBIT"
+
+  # The plan fails when normal_balance_type is changed or the account is
+  # locked while it still holds funds in this journal and currency.
+  protect_non_zero_balance = [
+    {
+      journal_id = cala_journal.journal.id
+      currency   = "USD"
+    },
+  ]
 }
@@ -0,0 +1,2 @@
+# Account sets are imported by ID.
+terraform import cala_account_set.assets 00000000-0000-0000-0000-000000000002
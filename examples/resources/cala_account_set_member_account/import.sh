@@ -0,0 +1,2 @@
+# Memberships are imported by account_set/<account set id>/account/<account id>.
+terraform import cala_account_set_member_account.cash account_set/00000000-0000-0000-0000-000000000002/account/00000000-0000-0000-0000-000000000001
@@ -0,0 +1,7 @@
+import {
+  to = cala_account_set_member_account_set.current_assets
+  identity = {
+    account_set_id = "00000000-0000-0000-0000-000000000002"
+    member_id      = "00000000-0000-0000-0000-000000000006"
+  }
+}
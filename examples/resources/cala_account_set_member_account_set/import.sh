@@ -0,0 +1,2 @@
+# Memberships are imported by account_set/<account set id>/member_account_set/<member account set id>.
+terraform import cala_account_set_member_account_set.current_assets account_set/00000000-0000-0000-0000-000000000002/member_account_set/00000000-0000-0000-0000-000000000006
@@ -0,0 +1,6 @@
+import {
+  to = cala_big_query_integration.export
+  identity = {
+    integration_id = "00000000-0000-0000-0000-000000000005"
+  }
+}
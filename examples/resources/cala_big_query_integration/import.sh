@@ -0,0 +1,2 @@
+# Integrations are imported by ID, the credentials cannot be read back and are taken from the configuration.
+terraform import cala_big_query_integration.export 00000000-0000-0000-0000-000000000005
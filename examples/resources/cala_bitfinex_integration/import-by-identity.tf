@@ -0,0 +1,6 @@
+import {
+  to = cala_bitfinex_integration.bfx
+  identity = {
+    integration_id = "00000000-0000-0000-0000-000000000004"
+  }
+}
@@ -0,0 +1,2 @@
+# Integrations are imported by ID, key and secret cannot be read back and are taken from the configuration.
+terraform import cala_bitfinex_integration.bfx 00000000-0000-0000-0000-000000000004
@@ -0,0 +1,6 @@
+import {
+  to = cala_journal.general_ledger
+  identity = {
+    id = "00000000-0000-0000-0000-000000000003"
+  }
+}
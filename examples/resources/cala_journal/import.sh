@@ -0,0 +1,2 @@
+# Journals are imported by ID.
+terraform import cala_journal.general_ledger 00000000-0000-0000-0000-000000000003
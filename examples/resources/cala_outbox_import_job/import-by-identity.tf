@@ -0,0 +1,6 @@
+import {
+  to = cala_outbox_import_job.upstream
+  identity = {
+    id = "00000000-0000-0000-0000-000000000007"
+  }
+}
@@ -0,0 +1,2 @@
+# Jobs are imported by ID.
+terraform import cala_outbox_import_job.upstream 00000000-0000-0000-0000-000000000007
@@ -0,0 +1,7 @@
+resource "random_uuid" "job_id" {}
+
+resource "cala_outbox_import_job" "upstream" {
+  id       = random_uuid.job_id.result
+  name     = "upstream"
+  endpoint = "http://upstream-cala:2253"
+}
@@ -0,0 +1,6 @@
+import {
+  to = cala_transaction.opening_balance
+  identity = {
+    id = "3f5a1c2e-8d4b-4f6a-9e1c-7b2d0a4c6e81"
+  }
+}
@@ -0,0 +1,3 @@
+# Transactions are imported by ID. The tx_template_code and params are taken
+# from the configuration without posting the transaction again.
+terraform import cala_transaction.opening_balance 3f5a1c2e-8d4b-4f6a-9e1c-7b2d0a4c6e81
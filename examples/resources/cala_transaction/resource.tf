@@ -0,0 +1,29 @@
+locals {
+  opening_balance_params = {
+    account_id = "3d2c5f4e-92b0-4b6b-9f0e-6d3f3c8e2a10"
+    amount     = "1000.00"
+    currency   = "USD"
+  }
+}
+
+# A new ID is generated whenever the params change, so the replacement is
+# posted as a new transaction.
+resource "random_uuid" "opening_balance_id" {
+  keepers = {
+    params = jsonencode(local.opening_balance_params)
+  }
+}
+
+# Posts an opening balance through an existing tx template. The template is
+# expected to declare the `account_id`, `amount` and `currency` params.
+resource "cala_transaction" "opening_balance" {
+  transaction_id   = random_uuid.opening_balance_id.result
+  tx_template_code = "OPENING_BALANCE"
+  params           = local.opening_balance_params
+
+  # Posts OPENING_BALANCE_REVERSAL with the same params on destroy, bringing
+  # the account back to a zero balance.
+  reversal {
+    tx_template_code = "OPENING_BALANCE_REVERSAL"
+  }
+}
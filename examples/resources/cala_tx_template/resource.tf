@@ -0,0 +1,107 @@
+resource "random_uuid" "journal_id" {}
+
+resource "cala_journal" "journal" {
+  id   = random_uuid.journal_id.result
+  name = "Default"
+}
+
+resource "random_uuid" "deposit_template_id" {}
+
+resource "cala_tx_template" "deposit" {
+  id   = random_uuid.deposit_template_id.result
+  code = "DEPOSIT"
+
+  params = [
+    {
+      name = "sender"
+      type = "UUID"
+    },
+    {
+      name = "recipient"
+      type = "UUID"
+    },
+    {
+      name = "amount"
+      type = "DECIMAL"
+    },
+    {
+      name    = "currency"
+      type    = "STRING"
+      default = "'USD'"
+    },
+  ]
+
+  transaction = {
+    effective  = "date()"
+    journal_id = "'${cala_journal.journal.id}'"
+  }
+
+  entries = [
+    {
+      entry_type = "'DEPOSIT_DR'"
+      account_id = "params.sender"
+      layer      = "SETTLED"
+      direction  = "DEBIT"
+      units      = "params.amount"
+      currency   = "params.currency"
+    },
+    {
+      entry_type = "'DEPOSIT_CR'"
+      account_id = "params.recipient"
+      layer      = "SETTLED"
+      direction  = "CREDIT"
+      units      = "params.amount"
+      currency   = "params.currency"
+    },
+  ]
+}
+
+# A versioned tx template. Changing it creates WITHDRAWAL_V2, WITHDRAWAL_V3...
+# next to the previous templates, applications post with current_code.
+resource "cala_tx_template" "withdrawal" {
+  base_code   = "WITHDRAWAL"
+  code_suffix = "VERSION"
+
+  params = [
+    {
+      name = "account"
+      type = "UUID"
+    },
+    {
+      name = "cash_account"
+      type = "UUID"
+    },
+    {
+      name = "amount"
+      type = "DECIMAL"
+    },
+  ]
+
+  transaction = {
+    effective  = "date()"
+    journal_id = "'${cala_journal.journal.id}'"
+  }
+
+  entries = [
+    {
+      entry_type = "'WITHDRAWAL_DR'"
+      account_id = "params.account"
+      layer      = "SETTLED"
+      direction  = "DEBIT"
+      units      = "params.amount"
+      currency   = "'USD'"
+    },
+    {
+      entry_type = "'WITHDRAWAL_CR'"
+      account_id = "params.cash_account"
+      layer      = "SETTLED"
+      direction  = "CREDIT"
+      units      = "params.amount"
+      currency   = "'USD'"
+    },
+  ]
+}
+
+output "withdrawal_tx_template_code" {
+  value = cala_tx_template.withdrawal.current_code
+}
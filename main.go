@@ -2,9 +2,14 @@ package main
 
 import (
 	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
 	"github.com/GaloyMoney/terraform-provider-cala/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
-	"log"
 )
 
 // Generate the Terraform provider documentation using `tfplugindocs`:
@@ -17,6 +22,13 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := export(os.Args[2:]); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
 	opts := providerserver.ServeOpts{
 		Address: "registry.terraform.io/GaloyMoney/cala",
 	}
@@ -27,3 +39,77 @@ func main() {
 		log.Fatal(err.Error())
 	}
 }
+
+// stringsFlag collects the values of a flag given several times.
+type stringsFlag []string
+
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringsFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+// export writes the configuration of an existing cala ledger, see
+// provider.Export.
+func export(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: terraform-provider-cala export [flags]\n\n"+
+			"Writes .tf files with import blocks for the accounts, account sets, memberships, journals\n"+
+			"and given integrations of a cala ledger.\n\nFlags:\n")
+		flags.PrintDefaults()
+	}
+
+	endpoint := flags.String("endpoint", os.Getenv("CALA_API_ENDPOINT"), "cala GraphQL endpoint, defaults to CALA_API_ENDPOINT")
+	out := flags.String("out", ".", "directory the .tf files are written to")
+	caCertFile := flags.String("ca-cert-file", "", "file with PEM encoded CA certificate(s) to trust in addition to the system pool")
+	clientCertFile := flags.String("client-cert-file", "", "file with the PEM encoded client certificate used for mutual TLS")
+	clientKeyFile := flags.String("client-key-file", "", "file with the PEM encoded private key of the client certificate")
+	insecureSkipVerify := flags.Bool("insecure-skip-verify", false, "disable verification of the server certificate, only for local development")
+	tlsServerName := flags.String("tls-server-name", "", "server name used to verify the server certificate, when it differs from the endpoint host")
+	proxyUrl := flags.String("proxy-url", "", "URL of the HTTP(S) proxy, defaults to HTTPS_PROXY/HTTP_PROXY")
+
+	var headers, accountSetIds, journalIds, bitfinexIds, bigQueryIds stringsFlag
+	flags.Var(&headers, "header", "HTTP header sent with every request, as name=value, repeatable")
+	flags.Var(&accountSetIds, "account-set-id", "account set exported even when it contains no account, repeatable")
+	flags.Var(&journalIds, "journal-id", "journal exported even when no account set uses it, repeatable")
+	flags.Var(&bitfinexIds, "bitfinex-integration-id", "bitfinex integration to export, repeatable")
+	flags.Var(&bigQueryIds, "bigquery-integration-id", "bigquery integration to export, repeatable")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *endpoint == "" {
+		return fmt.Errorf("missing endpoint, set -endpoint or CALA_API_ENDPOINT")
+	}
+
+	headerMap := map[string]string{}
+	for _, header := range headers {
+		name, value, ok := strings.Cut(header, "=")
+		if !ok {
+			return fmt.Errorf("invalid header %q, expected name=value", header)
+		}
+		headerMap[name] = value
+	}
+
+	return provider.Export(context.Background(), provider.ExportConfig{
+		Endpoint:               *endpoint,
+		Headers:                headerMap,
+		Version:                version,
+		CaCertFile:             *caCertFile,
+		ClientCertFile:         *clientCertFile,
+		ClientKeyFile:          *clientKeyFile,
+		InsecureSkipVerify:     *insecureSkipVerify,
+		TlsServerName:          *tlsServerName,
+		ProxyUrl:               *proxyUrl,
+		OutputDir:              *out,
+		AccountSetIds:          accountSetIds,
+		JournalIds:             journalIds,
+		BitfinexIntegrationIds: bitfinexIds,
+		BigQueryIntegrationIds: bigQueryIds,
+	})
+}
@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const redactedValue = "REDACTED"
+
+// sensitiveVariableNames are the mutation variables that are never written
+// to the audit log, in addition to those matched by sensitiveVariableParts.
+var sensitiveVariableNames = []string{"key", "secret", "serviceAccountCredsBase64"}
+
+var sensitiveVariableParts = []string{"secret", "password", "token", "cred", "privatekey", "apikey"}
+
+// auditLog appends one JSON line per mutation sent to the cala server. The
+// file is opened for every entry and synced before it is closed again, the
+// provider is not told when it is about to exit.
+type auditLog struct {
+	mu      sync.Mutex
+	path    string
+	context auditContext
+
+	// failures are the entries that could not be written, until a resource
+	// reports them.
+	failures []string
+}
+
+// auditContext is written with every entry of the audit log.
+type auditContext struct {
+	endpoint        string
+	workspace       string
+	providerVersion string
+}
+
+type auditEntry struct {
+	Time            string            `json:"time"`
+	Operation       string            `json:"operation"`
+	Variables       any               `json:"variables,omitempty"`
+	ResponseIds     map[string]string `json:"response_ids,omitempty"`
+	Error           string            `json:"error,omitempty"`
+	Endpoint        string            `json:"endpoint"`
+	Workspace       string            `json:"workspace"`
+	ProviderVersion string            `json:"provider_version"`
+}
+
+// openAuditLog checks that the audit log can be opened for appending,
+// creating it if needed, so that an unusable path is reported before any
+// mutation is sent.
+func openAuditLog(path string, info auditContext) (*auditLog, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := file.Close(); err != nil {
+		return nil, err
+	}
+
+	return &auditLog{path: path, context: info}, nil
+}
+
+func (l *auditLog) write(entry auditEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	file, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// fail records an entry that could not be written.
+func (l *auditLog) fail(operation string, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.failures = append(l.failures, fmt.Sprintf("The %s mutation was sent to the cala server but could not be recorded in the audit log %s: %s", operation, l.path, err))
+}
+
+// report adds a warning for every entry that could not be written since the
+// last report. It does nothing without an audit log.
+func (l *auditLog) report(diags *diag.Diagnostics) {
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	failures := l.failures
+	l.failures = nil
+	l.mu.Unlock()
+
+	for _, failure := range failures {
+		diags.AddWarning("Audit Log Not Written", failure)
+	}
+}
+
+// auditedClient records the mutations sent through it in the audit log.
+type auditedClient struct {
+	wrapped graphql.Client
+	log     *auditLog
+}
+
+func (c auditedClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	if !isMutation(req.Query) {
+		return c.wrapped.MakeRequest(ctx, req, resp)
+	}
+
+	err := c.wrapped.MakeRequest(ctx, req, resp)
+
+	entry := auditEntry{
+		Time:            time.Now().UTC().Format(time.RFC3339Nano),
+		Operation:       req.OpName,
+		Variables:       redactVariables(req.Variables),
+		ResponseIds:     responseIds(resp.Data),
+		Endpoint:        c.log.context.endpoint,
+		Workspace:       c.log.context.workspace,
+		ProviderVersion: c.log.context.providerVersion,
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	// The mutation has been sent at this point, failing it would leave the
+	// ledger changed without the change being recorded in the state.
+	if writeErr := c.log.write(entry); writeErr != nil {
+		tflog.Error(ctx, "unable to write the audit log", map[string]interface{}{"operation": req.OpName, "error": writeErr.Error()})
+		c.log.fail(req.OpName, writeErr)
+	}
+
+	return err
+}
+
+// redactVariables returns the JSON form of the variables of a request with
+// the values of sensitive variables replaced.
+func redactVariables(variables any) any {
+	if variables == nil {
+		return nil
+	}
+
+	encoded, err := json.Marshal(variables)
+	if err != nil {
+		return redactedValue
+	}
+
+	var decoded any
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return redactedValue
+	}
+
+	return redact(decoded)
+}
+
+func redact(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, nested := range v {
+			if isSensitiveVariable(key) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redact(nested)
+		}
+	case []any:
+		for i, nested := range v {
+			v[i] = redact(nested)
+		}
+	}
+
+	return value
+}
+
+func isSensitiveVariable(name string) bool {
+	for _, sensitive := range sensitiveVariableNames {
+		if name == sensitive {
+			return true
+		}
+	}
+
+	lower := strings.ToLower(name)
+	for _, part := range sensitiveVariableParts {
+		if strings.Contains(lower, part) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// responseIds collects the IDs in the response to a mutation, keyed by their
+// path, e.g. accountCreate.account.accountId.
+func responseIds(data any) map[string]string {
+	if data == nil {
+		return nil
+	}
+
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil
+	}
+
+	var decoded any
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil
+	}
+
+	ids := map[string]string{}
+	collectIds(decoded, "", ids)
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return ids
+}
+
+func collectIds(value any, path string, ids map[string]string) {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, nested := range v {
+			nestedPath := key
+			if path != "" {
+				nestedPath = path + "." + key
+			}
+
+			if id, ok := nested.(string); ok && (key == "id" || strings.HasSuffix(key, "Id")) {
+				ids[nestedPath] = id
+				continue
+			}
+
+			collectIds(nested, nestedPath, ids)
+		}
+	case []any:
+		for i, nested := range v {
+			collectIds(nested, fmt.Sprintf("%s[%d]", path, i), ids)
+		}
+	}
+}
+
+// terraformWorkspace returns the workspace Terraform runs in, taken from
+// TF_WORKSPACE or the workspace selected in the data directory.
+func terraformWorkspace() string {
+	if workspace := os.Getenv("TF_WORKSPACE"); workspace != "" {
+		return workspace
+	}
+
+	dataDir := os.Getenv("TF_DATA_DIR")
+	if dataDir == "" {
+		dataDir = ".terraform"
+	}
+
+	if selected, err := os.ReadFile(filepath.Join(dataDir, "environment")); err == nil {
+		if workspace := strings.TrimSpace(string(selected)); workspace != "" {
+			return workspace
+		}
+	}
+
+	return "default"
+}
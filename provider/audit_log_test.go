@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestIsSensitiveVariable(t *testing.T) {
+	cases := map[string]bool{
+		"key":                       true,
+		"secret":                    true,
+		"serviceAccountCredsBase64": true,
+		"clientSecret":              true,
+		"PASSWORD":                  true,
+		"accessToken":               true,
+		"credentials":               true,
+		"privateKey":                true,
+		"apiKey":                    true,
+		"accountId":                 false,
+		"name":                      false,
+		"code":                      false,
+		"keyId":                     false,
+		"metadata":                  false,
+	}
+
+	for name, sensitive := range cases {
+		if isSensitiveVariable(name) != sensitive {
+			t.Errorf("%s: expected sensitive to be %t", name, sensitive)
+		}
+	}
+}
+
+func TestRedactVariables(t *testing.T) {
+	type input struct {
+		Name   string            `json:"name"`
+		Key    string            `json:"key"`
+		Secret string            `json:"secret"`
+		Nested []map[string]any  `json:"nested"`
+		Tags   map[string]string `json:"tags"`
+	}
+
+	variables := map[string]any{
+		"input": input{
+			Name:   "bitfinex",
+			Key:    "api key",
+			Secret: "api secret",
+			Nested: []map[string]any{{"apiToken": "token", "id": "1"}},
+			Tags:   map[string]string{"password": "hunter2", "team": "ledger"},
+		},
+		"id": "b9a5ea5c-6ba6-4a29-a0e6-8b8ea7a64e5c",
+	}
+
+	expected := map[string]any{
+		"input": map[string]any{
+			"name":   "bitfinex",
+			"key":    redactedValue,
+			"secret": redactedValue,
+			"nested": []any{map[string]any{"apiToken": redactedValue, "id": "1"}},
+			"tags":   map[string]any{"password": redactedValue, "team": "ledger"},
+		},
+		"id": "b9a5ea5c-6ba6-4a29-a0e6-8b8ea7a64e5c",
+	}
+
+	if redacted := redactVariables(variables); !reflect.DeepEqual(redacted, expected) {
+		t.Errorf("expected %v, got %v", expected, redacted)
+	}
+
+	if redacted := redactVariables(nil); redacted != nil {
+		t.Errorf("expected no variables, got %v", redacted)
+	}
+
+	// The variables sent to the server are left untouched.
+	if variables["input"].(input).Secret != "api secret" {
+		t.Error("expected the request variables not to be modified")
+	}
+}
+
+func TestResponseIds(t *testing.T) {
+	data := map[string]any{
+		"accountSetCreate": map[string]any{
+			"accountSet": map[string]any{
+				"accountSetId": "1",
+				"journalId":    "2",
+				"name":         "Assets",
+				"members":      []any{map[string]any{"id": "3"}, map[string]any{"id": "4"}},
+			},
+		},
+	}
+
+	expected := map[string]string{
+		"accountSetCreate.accountSet.accountSetId":  "1",
+		"accountSetCreate.accountSet.journalId":     "2",
+		"accountSetCreate.accountSet.members[0].id": "3",
+		"accountSetCreate.accountSet.members[1].id": "4",
+	}
+
+	if ids := responseIds(data); !reflect.DeepEqual(ids, expected) {
+		t.Errorf("expected %v, got %v", expected, ids)
+	}
+
+	if ids := responseIds(map[string]any{"name": "Assets"}); ids != nil {
+		t.Errorf("expected no IDs, got %v", ids)
+	}
+}
+
+func TestAuditedClient(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	log, err := openAuditLog(path, auditContext{endpoint: "http://cala", workspace: "default", providerVersion: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := auditedClient{
+		wrapped: &fakeClient{responses: map[string]string{
+			"journalCreate": `{"journalCreate": {"journal": {"journalId": "1"}}}`,
+			"journalGet":    `{"journal": {"journalId": "1"}}`,
+		}},
+		log: log,
+	}
+
+	var data map[string]any
+	for _, request := range []*graphql.Request{
+		{OpName: "journalGet", Query: "query journalGet { journal { journalId } }"},
+		{OpName: "journalCreate", Query: "mutation journalCreate { journalCreate { journal { journalId } } }", Variables: map[string]any{"secret": "s"}},
+	} {
+		if err := client.MakeRequest(context.Background(), request, &graphql.Response{Data: &data}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(contents)), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("expected only the mutation to be recorded, got %q", contents)
+	}
+
+	var entry auditEntry
+	if err := json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatal(err)
+	}
+
+	if entry.Operation != "journalCreate" || entry.ResponseIds["journalCreate.journal.journalId"] != "1" || strings.Contains(lines[0], `"s"`) {
+		t.Errorf("unexpected entry %s", lines[0])
+	}
+
+	var diags diag.Diagnostics
+	log.report(&diags)
+	if len(diags) != 0 {
+		t.Fatalf("expected no failures, got %v", diags)
+	}
+
+	// An audit log that cannot be written does not fail the mutation, it is
+	// reported once as a warning.
+	log.path = filepath.Join(t.TempDir(), "missing", "audit.log")
+
+	request := &graphql.Request{OpName: "journalCreate", Query: "mutation journalCreate { journalCreate { journal { journalId } } }"}
+	if err := client.MakeRequest(context.Background(), request, &graphql.Response{Data: &data}); err != nil {
+		t.Fatal(err)
+	}
+
+	log.report(&diags)
+	if len(diags) != 1 || diags[0].Severity() != diag.SeverityWarning || !strings.Contains(diags[0].Detail(), "journalCreate") {
+		t.Fatalf("expected a warning for the journalCreate mutation, got %v", diags)
+	}
+
+	diags = nil
+	log.report(&diags)
+	if len(diags) != 0 {
+		t.Fatalf("expected the failure to be reported once, got %v", diags)
+	}
+
+	// Resources without an audit log report nothing.
+	var none *auditLog
+	none.report(&diags)
+}
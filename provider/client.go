@@ -1,13 +1,156 @@
 package provider
 
-import "net/http"
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
 
 type authedTransport struct {
-	endpoint string
-	wrapped  http.RoundTripper
+	endpoint  string
+	userAgent string
+	headers   map[string]string
+	wrapped   http.RoundTripper
 }
 
 func (t *authedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request it was given.
+	req = req.Clone(req.Context())
+
+	for name, value := range t.headers {
+		req.Header.Set(name, value)
+	}
+
+	if t.userAgent != "" && req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", t.userAgent)
+	}
+
+	// Tag every call so it can be correlated with the server logs.
+	if req.Header.Get("X-Request-Id") == "" {
+		req.Header.Set("X-Request-Id", uuid.NewString())
+	}
 
 	return t.wrapped.RoundTrip(req)
 }
+
+// userAgent returns the User-Agent sent with every request to the cala server.
+func userAgent(terraformVersion string, providerVersion string) string {
+	if terraformVersion == "" {
+		terraformVersion = "unknown"
+	}
+
+	return fmt.Sprintf("Terraform/%s (+https://www.terraform.io) terraform-provider-cala/%s", terraformVersion, providerVersion)
+}
+
+// transportConfig holds the TLS and proxy settings used to reach the cala server.
+type transportConfig struct {
+	caCertPem          string
+	caCertFile         string
+	clientCert         string
+	clientKey          string
+	insecureSkipVerify bool
+	tlsServerName      string
+	proxyUrl           string
+}
+
+// newTransport builds an *http.Transport from the default transport with the
+// given TLS and proxy settings applied.
+func newTransport(config transportConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         config.tlsServerName,
+		InsecureSkipVerify: config.insecureSkipVerify,
+	}
+
+	caCertPem := config.caCertPem
+	if config.caCertFile != "" {
+		contents, err := os.ReadFile(config.caCertFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read ca_cert_file: %w", err)
+		}
+		caCertPem = string(contents)
+	}
+
+	if caCertPem != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(caCertPem)) {
+			return nil, errors.New("no valid PEM encoded certificates found in CA certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.clientCert != "" || config.clientKey != "" {
+		certificate, err := tls.X509KeyPair([]byte(config.clientCert), []byte(config.clientKey))
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	if config.proxyUrl != "" {
+		proxyUrl, err := url.Parse(config.proxyUrl)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy_url: %w", err)
+		}
+		if proxyUrl.Scheme == "" || proxyUrl.Host == "" {
+			return nil, fmt.Errorf("invalid proxy_url %q: expected an absolute URL such as http://proxy:3128", config.proxyUrl)
+		}
+		transport.Proxy = http.ProxyURL(proxyUrl)
+	}
+
+	return transport, nil
+}
+
+// unconfiguredClient is handed out while the provider configuration still
+// contains values that are unknown until apply.
+type unconfiguredClient struct{}
+
+func (unconfiguredClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	return errors.New("the provider configuration depends on values that are not known yet, the cala server cannot be reached until they are")
+}
+
+// readOnlyClient refuses to send mutations, so that a provider configured
+// with read_only can plan against a ledger without ever changing it.
+type readOnlyClient struct {
+	wrapped graphql.Client
+}
+
+func (c readOnlyClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	if isMutation(req.Query) {
+		return fmt.Errorf("the provider is configured with read_only = true, refusing to send the %s mutation to the cala server", req.OpName)
+	}
+
+	return c.wrapped.MakeRequest(ctx, req, resp)
+}
+
+// readOnlyDiagnostic is returned by resources asked to change the ledger
+// while the provider is configured with read_only, including those whose
+// change would not send a mutation.
+func readOnlyDiagnostic(action string, object string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Provider Is Read Only",
+		fmt.Sprintf("Unable to %s %s, the provider is configured with read_only = true and does not change the ledger.", action, object),
+	)
+}
+
+// isMutation reports whether a GraphQL document is a mutation.
+func isMutation(query string) bool {
+	return strings.HasPrefix(strings.TrimSpace(query), "mutation")
+}
@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+)
+
+// fakeClient answers GraphQL requests with the JSON data registered for their
+// operation, and records the operations it was sent.
+type fakeClient struct {
+	responses map[string]string
+	// respond answers requests whose response depends on their variables. It
+	// takes precedence over responses.
+	respond    func(req *graphql.Request) (string, bool)
+	operations []string
+	requests   []*graphql.Request
+}
+
+func (c *fakeClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	c.operations = append(c.operations, req.OpName)
+	c.requests = append(c.requests, req)
+
+	data, ok := "", false
+	if c.respond != nil {
+		data, ok = c.respond(req)
+	}
+	if !ok {
+		data, ok = c.responses[req.OpName]
+	}
+	if !ok {
+		return fmt.Errorf("unexpected %s request", req.OpName)
+	}
+
+	return json.Unmarshal([]byte(data), resp.Data)
+}
+
+// testCertificate is a certificate with its key, PEM encoded.
+type testCertificate struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+	certPem     string
+	keyPem      string
+}
+
+// newTestCertificate creates a certificate signed by parent, or a self signed
+// CA when parent is nil.
+func newTestCertificate(t *testing.T, commonName string, parent *testCertificate) *testCertificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.certificate, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &testCertificate{
+		certificate: certificate,
+		key:         key,
+		certPem:     string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		keyPem:      string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})),
+	}
+}
+
+func serverCaPem(server *httptest.Server) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+}
+
+func get(t *testing.T, config transportConfig, url string) (*http.Response, error) {
+	t.Helper()
+
+	transport, err := newTransport(config)
+	if err != nil {
+		t.Fatalf("newTransport: %s", err)
+	}
+
+	client := http.Client{Transport: transport}
+
+	response, err := client.Get(url)
+	if err == nil {
+		response.Body.Close()
+	}
+
+	return response, err
+}
+
+func TestNewTransportCustomCa(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	if _, err := get(t, transportConfig{}, server.URL); err == nil {
+		t.Fatal("expected the server certificate to be rejected without its CA")
+	}
+
+	if _, err := get(t, transportConfig{caCertPem: serverCaPem(server)}, server.URL); err != nil {
+		t.Fatalf("ca_cert_pem: %s", err)
+	}
+
+	caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caCertFile, []byte(serverCaPem(server)), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := get(t, transportConfig{caCertFile: caCertFile}, server.URL); err != nil {
+		t.Fatalf("ca_cert_file: %s", err)
+	}
+}
+
+func TestNewTransportInvalidCa(t *testing.T) {
+	if _, err := newTransport(transportConfig{caCertPem: "not a certificate"}); err == nil {
+		t.Fatal("expected an error for an invalid CA certificate")
+	}
+
+	if _, err := newTransport(transportConfig{caCertFile: filepath.Join(t.TempDir(), "missing.pem")}); err == nil {
+		t.Fatal("expected an error for a missing CA certificate file")
+	}
+}
+
+func TestNewTransportClientCertificate(t *testing.T) {
+	clientCa := newTestCertificate(t, "client ca", nil)
+	client := newTestCertificate(t, "terraform", clientCa)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(clientCa.certificate)
+
+	var commonName string
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		commonName = r.TLS.PeerCertificates[0].Subject.CommonName
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: pool}
+	server.StartTLS()
+	defer server.Close()
+
+	if _, err := get(t, transportConfig{caCertPem: serverCaPem(server)}, server.URL); err == nil {
+		t.Fatal("expected the server to require a client certificate")
+	}
+
+	config := transportConfig{
+		caCertPem:  serverCaPem(server),
+		clientCert: client.certPem,
+		clientKey:  client.keyPem,
+	}
+
+	if _, err := get(t, config, server.URL); err != nil {
+		t.Fatalf("client certificate: %s", err)
+	}
+
+	if commonName != "terraform" {
+		t.Fatalf("expected the client certificate of terraform, got %q", commonName)
+	}
+
+	if _, err := newTransport(transportConfig{clientCert: client.certPem, clientKey: clientCa.keyPem}); err == nil {
+		t.Fatal("expected an error for a key not matching the client certificate")
+	}
+}
+
+func TestNewTransportInsecureSkipVerify(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	if _, err := get(t, transportConfig{insecureSkipVerify: true}, server.URL); err != nil {
+		t.Fatalf("insecure_skip_verify: %s", err)
+	}
+}
+
+func TestNewTransportTlsServerName(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	// The certificate of httptest servers is valid for example.com.
+	config := transportConfig{caCertPem: serverCaPem(server), tlsServerName: "example.com"}
+	if _, err := get(t, config, server.URL); err != nil {
+		t.Fatalf("tls_server_name: %s", err)
+	}
+
+	config.tlsServerName = "cala.example.org"
+	if _, err := get(t, config, server.URL); err == nil {
+		t.Fatal("expected a certificate name mismatch")
+	}
+}
+
+func TestNewTransportProxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+	}))
+	defer proxy.Close()
+
+	response, err := get(t, transportConfig{proxyUrl: proxy.URL}, "http://cala.internal/graphql")
+	if err != nil {
+		t.Fatalf("proxy_url: %s", err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("expected the proxy to answer, got status %d", response.StatusCode)
+	}
+
+	if proxied != "http://cala.internal/graphql" {
+		t.Fatalf("expected the request to be sent through the proxy, got %q", proxied)
+	}
+}
+
+func TestNewTransportInvalidProxy(t *testing.T) {
+	for _, proxyUrl := range []string{"proxy:3128", "://proxy", "/proxy"} {
+		_, err := newTransport(transportConfig{proxyUrl: proxyUrl})
+		if err == nil || !strings.Contains(err.Error(), "proxy_url") {
+			t.Errorf("expected a proxy_url error for %q, got %v", proxyUrl, err)
+		}
+	}
+}
+
+func TestAuthedTransport(t *testing.T) {
+	var userAgents, requestIds, tenants []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgents = append(userAgents, r.Header.Get("User-Agent"))
+		requestIds = append(requestIds, r.Header.Get("X-Request-Id"))
+		tenants = append(tenants, r.Header.Get("X-Tenant"))
+	}))
+	defer server.Close()
+
+	client := http.Client{Transport: &authedTransport{
+		endpoint:  server.URL,
+		userAgent: userAgent("1.9.0", "0.1.0"),
+		headers:   map[string]string{"X-Tenant": "acme"},
+		wrapped:   http.DefaultTransport,
+	}}
+
+	for i := 0; i < 3; i++ {
+		response, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response.Body.Close()
+	}
+
+	seen := map[string]bool{}
+	for i := range requestIds {
+		if userAgents[i] != "Terraform/1.9.0 (+https://www.terraform.io) terraform-provider-cala/0.1.0" {
+			t.Errorf("unexpected User-Agent %q", userAgents[i])
+		}
+		if tenants[i] != "acme" {
+			t.Errorf("expected the configured header, got %q", tenants[i])
+		}
+		if _, err := uuid.Parse(requestIds[i]); err != nil {
+			t.Errorf("expected X-Request-Id to be a UUID, got %q", requestIds[i])
+		}
+		if seen[requestIds[i]] {
+			t.Errorf("X-Request-Id %s was sent twice", requestIds[i])
+		}
+		seen[requestIds[i]] = true
+	}
+
+	// Headers set explicitly are kept.
+	client.Transport.(*authedTransport).headers = map[string]string{"User-Agent": "custom", "X-Request-Id": "fixed"}
+	response, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+
+	if userAgents[3] != "custom" || requestIds[3] != "fixed" {
+		t.Errorf("expected the configured User-Agent and X-Request-Id, got %q and %q", userAgents[3], requestIds[3])
+	}
+
+	if userAgent("", "dev") != "Terraform/unknown (+https://www.terraform.io) terraform-provider-cala/dev" {
+		t.Errorf("unexpected User-Agent without a Terraform version: %q", userAgent("", "dev"))
+	}
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// cryptoCurrenciesEnvVarName names the environment variable listing crypto
+// currency codes accepted in addition to defaultCryptoCurrencies, as CODE or
+// CODE:EXPONENT, e.g. `SOL:9,DOGE:8`. Currency codes are validated before the
+// provider is configured, so the list cannot be a provider attribute.
+const cryptoCurrenciesEnvVarName = "CALA_CRYPTO_CURRENCIES"
+
+// unknownExponent marks crypto currencies listed without their number of
+// minor unit digits.
+const unknownExponent int32 = -1
+
+// currencyCodeRegexp matches the codes cala accepts as CurrencyCode, ISO 4217
+// codes as well as longer crypto currency codes such as USDT.
+var currencyCodeRegexp = regexp.MustCompile(`^[A-Z]{3,5}$`)
+
+// currencyExponents are the number of minor unit digits of currencies that do
+// not use two, the default for ISO 4217 codes.
+var currencyExponents = map[string]int32{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"CLF": 4, "UYW": 4,
+}
+
+// isoCurrencies are the active ISO 4217 currency codes, including funds and
+// precious metals.
+var isoCurrencies = strings.Fields(`
+	AED AFN ALL AMD ANG AOA ARS AUD AWG AZN BAM BBD BDT BGN BHD BIF BMD BND BOB BOV
+	BRL BSD BTN BWP BYN BZD CAD CDF CHE CHF CHW CLF CLP CNY COP COU CRC CUC CUP CVE
+	CZK DJF DKK DOP DZD EGP ERN ETB EUR FJD FKP GBP GEL GHS GIP GMD GNF GTQ GYD HKD
+	HNL HTG HUF IDR ILS INR IQD IRR ISK JMD JOD JPY KES KGS KHR KMF KPW KRW KWD KYD
+	KZT LAK LBP LKR LRD LSL LYD MAD MDL MGA MKD MMK MNT MOP MRU MUR MVR MWK MXN MXV
+	MYR MZN NAD NGN NIO NOK NPR NZD OMR PAB PEN PGK PHP PKR PLN PYG QAR RON RSD RUB
+	RWF SAR SBD SCR SDG SEK SGD SHP SLE SLL SOS SRD SSP STN SVC SYP SZL THB TJS TMT
+	TND TOP TRY TTD TWD TZS UAH UGX USD USN UYI UYU UYW UZS VED VES VND VUV WST XAF
+	XAG XAU XBA XBB XBC XBD XCD XCG XDR XOF XPD XPF XPT XSU XTS XUA XXX YER ZAR ZMW
+	ZWG ZWL
+`)
+
+// defaultCryptoCurrencies are the exponents of the crypto currency codes
+// accepted without listing them in CALA_CRYPTO_CURRENCIES.
+var defaultCryptoCurrencies = map[string]int32{"BTC": 8, "ETH": 18, "USDT": 6, "USDC": 6}
+
+// cryptoCurrencies returns the exponent of each accepted crypto currency, or
+// unknownExponent when CALA_CRYPTO_CURRENCIES lists it without one.
+func cryptoCurrencies() map[string]int32 {
+	crypto := maps.Clone(defaultCryptoCurrencies)
+
+	for _, entry := range strings.Split(os.Getenv(cryptoCurrenciesEnvVarName), ",") {
+		code, digits, hasExponent := strings.Cut(strings.TrimSpace(entry), ":")
+		if code = strings.TrimSpace(code); code == "" {
+			continue
+		}
+
+		exponent := unknownExponent
+		if hasExponent {
+			if parsed, err := strconv.ParseInt(strings.TrimSpace(digits), 10, 32); err == nil && parsed >= 0 {
+				exponent = int32(parsed)
+			}
+		}
+
+		crypto[code] = exponent
+	}
+
+	return crypto
+}
+
+// knownCurrencies returns the set of accepted currency codes.
+func knownCurrencies() map[string]bool {
+	known := map[string]bool{}
+
+	for _, code := range isoCurrencies {
+		known[code] = true
+	}
+
+	for code := range cryptoCurrencies() {
+		known[code] = true
+	}
+
+	return known
+}
+
+// validateCurrencyCode checks that currency is an ISO 4217 code or a known
+// crypto currency code.
+func validateCurrencyCode(currency string) error {
+	if !currencyCodeRegexp.MatchString(currency) {
+		return fmt.Errorf("%q is not a valid currency code, codes are 3 to 5 upper case letters", currency)
+	}
+
+	if !knownCurrencies()[currency] {
+		return fmt.Errorf("%q is neither an ISO 4217 currency code nor a known crypto currency, "+
+			"crypto currencies other than %s can be added to the comma separated `%s` environment variable",
+			currency, strings.Join(slices.Sorted(maps.Keys(defaultCryptoCurrencies)), ", "), cryptoCurrenciesEnvVarName)
+	}
+
+	return nil
+}
+
+// currencyExponent returns the number of minor unit digits of a currency,
+// from the same crypto currencies validateCurrencyCode accepts.
+func currencyExponent(currency string) (int32, error) {
+	if !currencyCodeRegexp.MatchString(currency) {
+		return 0, fmt.Errorf("%q is not a valid currency code", currency)
+	}
+
+	if exponent, ok := cryptoCurrencies()[currency]; ok {
+		if exponent == unknownExponent {
+			return 0, fmt.Errorf("the minor units of %s are not known, list it as %s:<digits> in the `%s` environment variable",
+				currency, currency, cryptoCurrenciesEnvVarName)
+		}
+		return exponent, nil
+	}
+
+	if exponent, ok := currencyExponents[currency]; ok {
+		return exponent, nil
+	}
+
+	if len(currency) != 3 {
+		return 0, fmt.Errorf("the minor units of %s are not known, list it as %s:<digits> in the `%s` environment variable",
+			currency, currency, cryptoCurrenciesEnvVarName)
+	}
+
+	return 2, nil
+}
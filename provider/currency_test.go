@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestCurrencyExponent(t *testing.T) {
+	t.Setenv(cryptoCurrenciesEnvVarName, "SOL:9, DOGE:8,XMR")
+
+	cases := []struct {
+		currency string
+		exponent int32
+		err      string
+	}{
+		{"USD", 2, ""},
+		{"EUR", 2, ""},
+		{"JPY", 0, ""},
+		{"KWD", 3, ""},
+		{"CLF", 4, ""},
+		{"BTC", 8, ""},
+		{"USDT", 6, ""},
+		{"ETH", 18, ""},
+		{"SOL", 9, ""},
+		{"DOGE", 8, ""},
+		{"XMR", 0, "XMR:<digits>"},
+		{"SHIB", 0, "SHIB:<digits>"},
+		{"usd", 0, "not a valid currency code"},
+	}
+
+	for _, c := range cases {
+		exponent, err := currencyExponent(c.currency)
+
+		if c.err != "" {
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Errorf("%s: expected an error containing %q, got %v", c.currency, c.err, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", c.currency, err)
+			continue
+		}
+
+		if exponent != c.exponent {
+			t.Errorf("%s: expected %d minor unit digits, got %d", c.currency, c.exponent, exponent)
+		}
+
+		// Every currency with a known exponent is also accepted as a code.
+		if err := validateCurrencyCode(c.currency); err != nil {
+			t.Errorf("%s: %s", c.currency, err)
+		}
+	}
+}
+
+func TestFormatMoney(t *testing.T) {
+	cases := []struct {
+		units    string
+		exponent int32
+		result   string
+	}{
+		{"0", 2, "0.00"},
+		{"1.5", 2, "1.50"},
+		{"1.005", 2, "1.01"},
+		{"1.004", 2, "1.00"},
+		{"-1.005", 2, "-1.01"},
+		{"-0.004", 2, "0.00"},
+		{"999.995", 2, "1,000.00"},
+		{"1234567.891", 2, "1,234,567.89"},
+		{"-1234567.891", 2, "-1,234,567.89"},
+		{"1234.5", 0, "1,235"},
+		{"0.5", 0, "1"},
+		{"123", 3, "123.000"},
+		{"0.123456789", 8, "0.12345679"},
+		{"1000000", 18, "1,000,000.000000000000000000"},
+	}
+
+	for _, c := range cases {
+		result := formatMoney(decimal.RequireFromString(c.units), c.exponent)
+		if result != c.result {
+			t.Errorf("%s with %d digits: expected %s, got %s", c.units, c.exponent, c.result, result)
+		}
+	}
+}
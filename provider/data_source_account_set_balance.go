@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &AccountSetBalanceDataSource{}
+
+func NewAccountSetBalanceDataSource() datasource.DataSource {
+	return &AccountSetBalanceDataSource{}
+}
+
+type AccountSetBalanceDataSource struct {
+	client   *graphql.Client
+	defaults CalaProviderDefaults
+}
+
+type AccountSetBalanceDataSourceModel struct {
+	AccountSetId UUIDValue                     `tfsdk:"account_set_id"`
+	Currency     CurrencyCodeValue             `tfsdk:"currency"`
+	JournalId    UUIDValue                     `tfsdk:"journal_id"`
+	From         TimestampValue                `tfsdk:"from"`
+	Until        TimestampValue                `tfsdk:"until"`
+	Settled      BalanceAmountModel            `tfsdk:"settled"`
+	Pending      BalanceAmountModel            `tfsdk:"pending"`
+	Encumbrance  BalanceAmountModel            `tfsdk:"encumbrance"`
+	Available    map[string]BalanceAmountModel `tfsdk:"available"`
+}
+
+type BalanceAmountModel struct {
+	DrBalance     DecimalValue `tfsdk:"dr_balance"`
+	CrBalance     DecimalValue `tfsdk:"cr_balance"`
+	NormalBalance DecimalValue `tfsdk:"normal_balance"`
+}
+
+// balanceAmountAttributes describes a BalanceAmount, it is shared by the
+// data sources reading balances.
+func balanceAmountAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"dr_balance": schema.StringAttribute{
+			CustomType:          DecimalType{},
+			MarkdownDescription: "Sum of the debits, as a decimal string.",
+			Computed:            true,
+		},
+		"cr_balance": schema.StringAttribute{
+			CustomType:          DecimalType{},
+			MarkdownDescription: "Sum of the credits, as a decimal string.",
+			Computed:            true,
+		},
+		"normal_balance": schema.StringAttribute{
+			CustomType:          DecimalType{},
+			MarkdownDescription: "Balance in the direction of the normal balance type, as a decimal string.",
+			Computed:            true,
+		},
+	}
+}
+
+// zeroBalanceAmount is reported for accounts and account sets without
+// entries in the currency.
+var zeroBalanceAmount = BalanceAmountModel{
+	DrBalance:     NewDecimalValue("0"),
+	CrBalance:     NewDecimalValue("0"),
+	NormalBalance: NewDecimalValue("0"),
+}
+
+func flattenBalanceAmount(amount *balanceAmountFields) BalanceAmountModel {
+	return BalanceAmountModel{
+		DrBalance:     NewDecimalValue(amount.DrBalance.Units),
+		CrBalance:     NewDecimalValue(amount.CrBalance.Units),
+		NormalBalance: NewDecimalValue(amount.NormalBalance.Units),
+	}
+}
+
+func (d *AccountSetBalanceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_set_balance"
+}
+
+func (d *AccountSetBalanceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Balance of a cala account set, aggregated over all of its members. Amounts are zero when the " +
+			"account set has no entries in the currency.",
+		Attributes: map[string]schema.Attribute{
+			"account_set_id": schema.StringAttribute{
+				CustomType:          UUIDType{},
+				MarkdownDescription: "ID of the account set.",
+				Required:            true,
+			},
+			"currency": schema.StringAttribute{
+				CustomType:          CurrencyCodeType{},
+				MarkdownDescription: "Currency of the balance. Defaults to the `default_currency` of the provider.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"journal_id": schema.StringAttribute{
+				CustomType:          UUIDType{},
+				MarkdownDescription: "ID of the journal of the account set.",
+				Computed:            true,
+			},
+			"from": schema.StringAttribute{
+				CustomType: TimestampType{},
+				MarkdownDescription: "Start of the range, as an RFC 3339 timestamp. When set the amounts are the change of " +
+					"the balance between `from` and `until` instead of the current balance.",
+				Optional: true,
+			},
+			"until": schema.StringAttribute{
+				CustomType:          TimestampType{},
+				MarkdownDescription: "End of the range, as an RFC 3339 timestamp. Defaults to now. Requires `from`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("from")),
+				},
+			},
+			"settled": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settled layer of the balance.",
+				Computed:            true,
+				Attributes:          balanceAmountAttributes(),
+			},
+			"pending": schema.SingleNestedAttribute{
+				MarkdownDescription: "Pending layer of the balance.",
+				Computed:            true,
+				Attributes:          balanceAmountAttributes(),
+			},
+			"encumbrance": schema.SingleNestedAttribute{
+				MarkdownDescription: "Encumbrance layer of the balance.",
+				Computed:            true,
+				Attributes:          balanceAmountAttributes(),
+			},
+			"available": schema.MapNestedAttribute{
+				MarkdownDescription: "Available balance keyed by layer, `SETTLED`, `PENDING` and `ENCUMBRANCE`. `PENDING` " +
+					"includes the settled amounts, `ENCUMBRANCE` includes both.",
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: balanceAmountAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *AccountSetBalanceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*CalaProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CalaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = &providerData.Client
+	d.defaults = providerData.Defaults
+}
+
+func (d *AccountSetBalanceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AccountSetBalanceDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Currency.IsNull() {
+		if d.defaults.Currency == "" {
+			resp.Diagnostics.AddError("Missing Currency", "Set currency on the data source or default_currency on the provider.")
+			return
+		}
+		data.Currency = NewCurrencyCodeValue(d.defaults.Currency)
+	}
+
+	data.Settled, data.Pending, data.Encumbrance = zeroBalanceAmount, zeroBalanceAmount, zeroBalanceAmount
+	data.Available = map[string]BalanceAmountModel{
+		"SETTLED":     zeroBalanceAmount,
+		"PENDING":     zeroBalanceAmount,
+		"ENCUMBRANCE": zeroBalanceAmount,
+	}
+
+	if data.From.IsNull() {
+		response, err := accountSetBalanceGet(ctx, *d.client, data.AccountSetId.ValueString(), data.Currency.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read account set balance, got error: %s", err))
+			return
+		}
+
+		if response.AccountSet == nil {
+			resp.Diagnostics.AddError("Account Set Not Found", fmt.Sprintf("No account set found for id %q.", data.AccountSetId.ValueString()))
+			return
+		}
+
+		tflog.Trace(ctx, "read an account set balance")
+
+		data.JournalId = NewUUIDValue(response.AccountSet.JournalId)
+
+		if balance := response.AccountSet.Balance; balance != nil {
+			flattenBalance(&balance.balanceFields, &data.Settled, &data.Pending, &data.Encumbrance, data.Available)
+		}
+	} else {
+		resp.Diagnostics.Append(d.readBalanceInRange(ctx, &data)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readBalanceInRange reads the change of the balance between from and until.
+// The range is read in the journal of the account set, which is looked up
+// first.
+func (d *AccountSetBalanceDataSource) readBalanceInRange(ctx context.Context, data *AccountSetBalanceDataSourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	accountSet, err := accountSetGet(ctx, *d.client, data.AccountSetId.ValueString())
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read account set, got error: %s", err))
+		return diags
+	}
+
+	if accountSet.AccountSet == nil {
+		diags.AddError("Account Set Not Found", fmt.Sprintf("No account set found for id %q.", data.AccountSetId.ValueString()))
+		return diags
+	}
+
+	response, err := accountSetBalanceInRangeGet(
+		ctx, *d.client, data.AccountSetId.ValueString(), accountSet.AccountSet.JournalId, data.Currency.ValueString(),
+		data.From.ValueString(), data.Until.ValueStringPointer(),
+	)
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read account set balance in range, got error: %s", err))
+		return diags
+	}
+
+	if response.AccountSet == nil {
+		diags.AddError("Account Set Not Found", fmt.Sprintf("No account set found for id %q.", data.AccountSetId.ValueString()))
+		return diags
+	}
+
+	tflog.Trace(ctx, "read an account set balance in range")
+
+	data.JournalId = NewUUIDValue(response.AccountSet.JournalId)
+
+	if balance := response.AccountSet.BalanceInRange; balance != nil {
+		flattenBalance(&balance.Diff.balanceFields, &data.Settled, &data.Pending, &data.Encumbrance, data.Available)
+	}
+
+	return diags
+}
+
+// flattenBalance copies the layers of a balance into the given models.
+func flattenBalance(balance *balanceFields, settled, pending, encumbrance *BalanceAmountModel, available map[string]BalanceAmountModel) {
+	*settled = flattenBalanceAmount(&balance.Settled.balanceAmountFields)
+	*pending = flattenBalanceAmount(&balance.Pending.balanceAmountFields)
+	*encumbrance = flattenBalanceAmount(&balance.Encumbrance.balanceAmountFields)
+
+	available["SETTLED"] = flattenBalanceAmount(&balance.AvailableSettled.balanceAmountFields)
+	available["PENDING"] = flattenBalanceAmount(&balance.AvailablePending.balanceAmountFields)
+	available["ENCUMBRANCE"] = flattenBalanceAmount(&balance.AvailableEncumbrance.balanceAmountFields)
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const testBalanceJournalId = "30000000-0000-0000-0000-000000000001"
+
+// testBalanceJson is a balance in the shape of the balanceFields fragment,
+// with every layer holding the same amounts.
+func testBalanceJson(dr, cr, normal string) string {
+	amount := fmt.Sprintf(`{"drBalance": {"units": %q}, "crBalance": {"units": %q}, "normalBalance": {"units": %q}}`, dr, cr, normal)
+	return fmt.Sprintf(`{"currency": "USD", "settled": %[1]s, "pending": %[1]s, "encumbrance": %[1]s, `+
+		`"availableSettled": %[1]s, "availablePending": %[1]s, "availableEncumbrance": %[1]s}`, amount)
+}
+
+func readAccountSetBalance(t *testing.T, client *fakeClient, config AccountSetBalanceDataSourceModel) (*AccountSetBalanceDataSourceModel, diag.Diagnostics) {
+	t.Helper()
+
+	ctx := context.Background()
+	var graphqlClient graphql.Client = client
+	d := &AccountSetBalanceDataSource{client: &graphqlClient, defaults: CalaProviderDefaults{Currency: "USD"}}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	null := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	config.JournalId = NewUUIDUnknown()
+	configState := tfsdk.State{Schema: schemaResp.Schema, Raw: null}
+	if diags := configState.Set(ctx, &config); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	req := datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: configState.Raw}}
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: null}}
+	d.Read(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		return nil, resp.Diagnostics
+	}
+
+	var data *AccountSetBalanceDataSourceModel
+	if diags := resp.State.Get(ctx, &data); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	return data, resp.Diagnostics
+}
+
+func TestAccountSetBalanceInRange(t *testing.T) {
+	var rangeInput *__accountSetBalanceInRangeGetInput
+
+	client := &fakeClient{
+		responses: map[string]string{
+			"accountSetBalanceGet": `{"accountSet": {"accountSetId": "` + testRootSetId + `", "journalId": "` + testBalanceJournalId + `", ` +
+				`"balance": ` + testBalanceJson("100", "40", "60") + `}}`,
+			"accountSetGet": `{"accountSet": {"accountSetId": "` + testRootSetId + `", "journalId": "` + testBalanceJournalId + `", ` +
+				`"name": "Assets", "normalBalanceType": "DEBIT"}}`,
+		},
+		respond: func(req *graphql.Request) (string, bool) {
+			input, ok := req.Variables.(*__accountSetBalanceInRangeGetInput)
+			if !ok {
+				return "", false
+			}
+			rangeInput = input
+			return `{"accountSet": {"accountSetId": "` + testRootSetId + `", "journalId": "` + testBalanceJournalId + `", ` +
+				`"balanceInRange": {"diff": ` + testBalanceJson("25", "5", "20") + `}}}`, true
+		},
+	}
+
+	config := AccountSetBalanceDataSourceModel{
+		AccountSetId: NewUUIDValue(testRootSetId),
+		Currency:     NewCurrencyCodeNull(),
+		From:         NewTimestampNull(),
+		Until:        NewTimestampNull(),
+	}
+
+	// Without a range the current balance is read.
+	data, diags := readAccountSetBalance(t, client, config)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if data.Settled.NormalBalance.ValueString() != "60" || data.Available["PENDING"].DrBalance.ValueString() != "100" ||
+		data.JournalId.ValueString() != testBalanceJournalId {
+		t.Errorf("expected the current balance, got %+v", data)
+	}
+
+	// With a range the change of the balance in the journal of the account
+	// set is read.
+	client.operations = nil
+	config.From = NewTimestampValue("2024-01-01T00:00:00Z")
+	config.Until = NewTimestampValue("2024-02-01T00:00:00Z")
+
+	data, diags = readAccountSetBalance(t, client, config)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if !slices.Equal(client.operations, []string{"accountSetGet", "accountSetBalanceInRangeGet"}) {
+		t.Fatalf("expected the journal of the account set to be read first, got %v", client.operations)
+	}
+
+	if rangeInput.JournalId != testBalanceJournalId || rangeInput.Currency != "USD" || rangeInput.From != "2024-01-01T00:00:00Z" ||
+		rangeInput.Until == nil || *rangeInput.Until != "2024-02-01T00:00:00Z" {
+		t.Errorf("unexpected range variables %+v", rangeInput)
+	}
+
+	if data.Settled.NormalBalance.ValueString() != "20" || data.Encumbrance.CrBalance.ValueString() != "5" ||
+		data.Available["SETTLED"].DrBalance.ValueString() != "25" {
+		t.Errorf("expected the change of the balance, got %+v", data)
+	}
+
+	// until is optional, the range then ends now.
+	config.Until = NewTimestampNull()
+
+	if _, diags = readAccountSetBalance(t, client, config); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if rangeInput.Until != nil {
+		t.Errorf("expected no until, got %s", *rangeInput.Until)
+	}
+
+	// A range without entries has zero amounts.
+	client.respond = func(req *graphql.Request) (string, bool) {
+		if _, ok := req.Variables.(*__accountSetBalanceInRangeGetInput); !ok {
+			return "", false
+		}
+		return `{"accountSet": {"accountSetId": "` + testRootSetId + `", "journalId": "` + testBalanceJournalId + `", "balanceInRange": null}}`, true
+	}
+
+	data, diags = readAccountSetBalance(t, client, config)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if data.Settled.NormalBalance.ValueString() != "0" || data.Available["ENCUMBRANCE"].CrBalance.ValueString() != "0" {
+		t.Errorf("expected zero amounts, got %+v", data)
+	}
+}
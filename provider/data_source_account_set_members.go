@@ -0,0 +1,307 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &AccountSetMembersDataSource{}
+
+const (
+	defaultAccountSetMembersPageSize = 100
+	defaultAccountSetMembersMaxDepth = 10
+)
+
+func NewAccountSetMembersDataSource() datasource.DataSource {
+	return &AccountSetMembersDataSource{}
+}
+
+type AccountSetMembersDataSource struct {
+	client *graphql.Client
+}
+
+type AccountSetMembersDataSourceModel struct {
+	AccountSetId UUIDValue                      `tfsdk:"account_set_id"`
+	Recursive    types.Bool                     `tfsdk:"recursive"`
+	MaxDepth     types.Int64                    `tfsdk:"max_depth"`
+	Members      []AccountSetMemberModel        `tfsdk:"members"`
+	Accounts     []AccountSetMemberAccountModel `tfsdk:"accounts"`
+}
+
+type AccountSetMemberModel struct {
+	MemberId UUIDValue    `tfsdk:"id"`
+	Type     types.String `tfsdk:"type"`
+	Code     types.String `tfsdk:"code"`
+	Name     types.String `tfsdk:"name"`
+}
+
+type AccountSetMemberAccountModel struct {
+	AccountId    UUIDValue    `tfsdk:"id"`
+	Code         types.String `tfsdk:"code"`
+	Name         types.String `tfsdk:"name"`
+	AccountSetId UUIDValue    `tfsdk:"account_set_id"`
+	Depth        types.Int64  `tfsdk:"depth"`
+}
+
+func (d *AccountSetMembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_set_members"
+}
+
+func (d *AccountSetMembersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Members of a cala account set, optionally expanding nested account sets.",
+		Attributes: map[string]schema.Attribute{
+			"account_set_id": schema.StringAttribute{
+				CustomType:          UUIDType{},
+				MarkdownDescription: "ID of the account set.",
+				Required:            true,
+			},
+			"recursive": schema.BoolAttribute{
+				MarkdownDescription: "Expand nested account sets into `accounts`. Account sets reached more than once are only " +
+					"expanded the first time, which also stops cycles.",
+				Optional: true,
+			},
+			"max_depth": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum nesting depth expanded when `recursive` is set, reading fails "+
+					"when the tree is deeper. Defaults to %d.", defaultAccountSetMembersMaxDepth),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"members": schema.ListNestedAttribute{
+				MarkdownDescription: "Direct members of the account set.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							CustomType:          UUIDType{},
+							MarkdownDescription: "ID of the account or account set.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the member, `ACCOUNT` or `ACCOUNT_SET`.",
+							Computed:            true,
+						},
+						"code": schema.StringAttribute{
+							MarkdownDescription: "Code of the account, null for account sets.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the account or account set.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"accounts": schema.ListNestedAttribute{
+				MarkdownDescription: "Accounts in the account set. Without `recursive` these are the direct account members, " +
+					"with it the leaf accounts of every nested account set, each listed once.",
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							CustomType:          UUIDType{},
+							MarkdownDescription: "ID of the account.",
+							Computed:            true,
+						},
+						"code": schema.StringAttribute{
+							MarkdownDescription: "Code of the account.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the account.",
+							Computed:            true,
+						},
+						"account_set_id": schema.StringAttribute{
+							CustomType:          UUIDType{},
+							MarkdownDescription: "ID of the account set the account was found in.",
+							Computed:            true,
+						},
+						"depth": schema.Int64Attribute{
+							MarkdownDescription: "Depth of the account below the account set, 1 for direct members.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AccountSetMembersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*CalaProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CalaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = &providerData.Client
+}
+
+func (d *AccountSetMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AccountSetMembersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountSetId := data.AccountSetId.ValueString()
+
+	members, diags := d.listMembers(ctx, accountSetId)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Members = make([]AccountSetMemberModel, 0, len(members))
+	for _, member := range members {
+		data.Members = append(data.Members, accountSetMemberModel(member))
+	}
+
+	maxDepth := int64(defaultAccountSetMembersMaxDepth)
+	if !data.MaxDepth.IsNull() {
+		maxDepth = data.MaxDepth.ValueInt64()
+	}
+
+	data.Accounts = []AccountSetMemberAccountModel{}
+
+	seenAccounts := map[string]bool{}
+	seenSets := map[string]bool{accountSetId: true}
+
+	// Walk the tree breadth first so accounts are reported at the shallowest
+	// depth they are found at.
+	type pending struct {
+		accountSetId string
+		members      []accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSetMember
+	}
+	queue := []pending{{accountSetId, members}}
+
+	for depth := int64(1); len(queue) > 0; depth++ {
+		var next []pending
+
+		for _, set := range queue {
+			for _, member := range set.members {
+				switch m := member.(type) {
+				case *accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccount:
+					if seenAccounts[m.AccountId] {
+						continue
+					}
+					seenAccounts[m.AccountId] = true
+					data.Accounts = append(data.Accounts, AccountSetMemberAccountModel{
+						AccountId:    NewUUIDValue(m.AccountId),
+						Code:         types.StringValue(m.Code),
+						Name:         types.StringValue(m.Name),
+						AccountSetId: NewUUIDValue(set.accountSetId),
+						Depth:        types.Int64Value(depth),
+					})
+
+				case *accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSet:
+					if !data.Recursive.ValueBool() || seenSets[m.AccountSetId] {
+						continue
+					}
+					seenSets[m.AccountSetId] = true
+
+					if depth >= maxDepth {
+						resp.Diagnostics.AddError(
+							"Maximum Depth Exceeded",
+							fmt.Sprintf("Account set %s is nested more than %d levels below account set %s, raise max_depth to expand it.", m.AccountSetId, maxDepth, accountSetId),
+						)
+						return
+					}
+
+					nested, diags := d.listMembers(ctx, m.AccountSetId)
+					resp.Diagnostics.Append(diags...)
+
+					if resp.Diagnostics.HasError() {
+						return
+					}
+
+					next = append(next, pending{m.AccountSetId, nested})
+				}
+			}
+		}
+
+		queue = next
+	}
+
+	tflog.Trace(ctx, "listed account set members", map[string]interface{}{"sets": len(seenSets), "accounts": len(data.Accounts)})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listMembers reads every page of the direct members of an account set.
+func (d *AccountSetMembersDataSource) listMembers(ctx context.Context, accountSetId string) ([]accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSetMember, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var members []accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSetMember
+	var after *string
+
+	for {
+		response, err := accountSetMembersList(ctx, *d.client, accountSetId, defaultAccountSetMembersPageSize, after)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to list members of account set %s, got error: %s", accountSetId, err))
+			return nil, diags
+		}
+
+		if response.AccountSet == nil {
+			diags.AddError("Account Set Not Found", fmt.Sprintf("No account set found for id %q.", accountSetId))
+			return nil, diags
+		}
+
+		connection := response.AccountSet.Members
+		members = append(members, connection.Nodes...)
+
+		if !connection.PageInfo.HasNextPage || connection.PageInfo.EndCursor == nil {
+			return members, diags
+		}
+		after = connection.PageInfo.EndCursor
+	}
+}
+
+func accountSetMemberModel(member accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSetMember) AccountSetMemberModel {
+	switch m := member.(type) {
+	case *accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccount:
+		return AccountSetMemberModel{
+			MemberId: NewUUIDValue(m.AccountId),
+			Type:     types.StringValue("ACCOUNT"),
+			Code:     types.StringValue(m.Code),
+			Name:     types.StringValue(m.Name),
+		}
+	case *accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSet:
+		return AccountSetMemberModel{
+			MemberId: NewUUIDValue(m.AccountSetId),
+			Type:     types.StringValue("ACCOUNT_SET"),
+			Code:     types.StringNull(),
+			Name:     types.StringValue(m.Name),
+		}
+	}
+
+	return AccountSetMemberModel{
+		MemberId: NewUUIDNull(),
+		Type:     types.StringPointerValue(member.GetTypename()),
+		Code:     types.StringNull(),
+		Name:     types.StringNull(),
+	}
+}
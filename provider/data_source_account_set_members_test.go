@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	testRootSetId = "10000000-0000-0000-0000-000000000000"
+	testSetId1    = "10000000-0000-0000-0000-000000000001"
+	testSetId2    = "10000000-0000-0000-0000-000000000002"
+	testSetId3    = "10000000-0000-0000-0000-000000000003"
+	testAccountA  = "20000000-0000-0000-0000-00000000000a"
+	testAccountB  = "20000000-0000-0000-0000-00000000000b"
+	testAccountC  = "20000000-0000-0000-0000-00000000000c"
+)
+
+func accountMemberNode(accountId string) string {
+	return fmt.Sprintf(`{"__typename": "Account", "accountId": %q, "code": %q, "name": %q}`, accountId, accountId[len(accountId)-1:], accountId)
+}
+
+func accountSetMemberNode(accountSetId string) string {
+	return fmt.Sprintf(`{"__typename": "AccountSet", "accountSetId": %q, "name": %q}`, accountSetId, accountSetId)
+}
+
+// accountSetMembersClient answers accountSetMembersList with the pages of
+// nodes of each account set, the cursor of a page being its index.
+func accountSetMembersClient(pages map[string][][]string) *fakeClient {
+	return &fakeClient{respond: func(req *graphql.Request) (string, bool) {
+		variables, ok := req.Variables.(*__accountSetMembersListInput)
+		if !ok {
+			return "", false
+		}
+
+		setPages, ok := pages[variables.Id]
+		if !ok {
+			return `{"accountSet": null}`, true
+		}
+
+		page := 0
+		if variables.After != nil {
+			fmt.Sscan(*variables.After, &page)
+		}
+
+		hasNextPage := page+1 < len(setPages)
+		return fmt.Sprintf(`{"accountSet": {"members": {"pageInfo": {"hasNextPage": %t, "endCursor": "%d"}, "nodes": [%s]}}}`,
+			hasNextPage, page+1, strings.Join(setPages[page], ", ")), true
+	}}
+}
+
+func readAccountSetMembers(t *testing.T, client *fakeClient, config AccountSetMembersDataSourceModel) (*AccountSetMembersDataSourceModel, diag.Diagnostics) {
+	t.Helper()
+
+	ctx := context.Background()
+	var graphqlClient graphql.Client = client
+	d := &AccountSetMembersDataSource{client: &graphqlClient}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	null := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	configState := tfsdk.State{Schema: schemaResp.Schema, Raw: null}
+	if diags := configState.Set(ctx, &config); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	req := datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: configState.Raw}}
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: null}}
+	d.Read(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		return nil, resp.Diagnostics
+	}
+
+	var data *AccountSetMembersDataSourceModel
+	if diags := resp.State.Get(ctx, &data); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	return data, resp.Diagnostics
+}
+
+func accountSetMembersConfig(recursive bool, maxDepth types.Int64) AccountSetMembersDataSourceModel {
+	return AccountSetMembersDataSourceModel{
+		AccountSetId: NewUUIDValue(testRootSetId),
+		Recursive:    types.BoolValue(recursive),
+		MaxDepth:     maxDepth,
+	}
+}
+
+// accountSetMemberAccounts formats accounts as id@account_set_id/depth.
+func accountSetMemberAccounts(accounts []AccountSetMemberAccountModel) []string {
+	result := make([]string, 0, len(accounts))
+	for _, account := range accounts {
+		result = append(result, fmt.Sprintf("%s@%s/%d", account.AccountId.ValueString(), account.AccountSetId.ValueString(), account.Depth.ValueInt64()))
+	}
+	return result
+}
+
+func TestAccountSetMembersCycle(t *testing.T) {
+	// The root contains set 1, which contains set 2, which contains both
+	// the root and set 1 again, along with an account already found.
+	client := accountSetMembersClient(map[string][][]string{
+		testRootSetId: {{accountMemberNode(testAccountA), accountSetMemberNode(testSetId1)}},
+		testSetId1:    {{accountMemberNode(testAccountB), accountSetMemberNode(testSetId2)}},
+		testSetId2:    {{accountSetMemberNode(testRootSetId), accountSetMemberNode(testSetId1), accountMemberNode(testAccountA), accountMemberNode(testAccountC)}},
+	})
+
+	data, diags := readAccountSetMembers(t, client, accountSetMembersConfig(true, types.Int64Null()))
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	expected := []string{
+		testAccountA + "@" + testRootSetId + "/1",
+		testAccountB + "@" + testSetId1 + "/2",
+		testAccountC + "@" + testSetId2 + "/3",
+	}
+
+	if accounts := accountSetMemberAccounts(data.Accounts); strings.Join(accounts, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected accounts %v, got %v", expected, accounts)
+	}
+
+	if len(data.Members) != 2 {
+		t.Errorf("expected the 2 direct members, got %v", data.Members)
+	}
+
+	// Each account set is listed once.
+	if len(client.operations) != 3 {
+		t.Errorf("expected 3 account sets to be listed, got %v", client.operations)
+	}
+}
+
+func TestAccountSetMembersMaxDepth(t *testing.T) {
+	chain := map[string][][]string{
+		testRootSetId: {{accountMemberNode(testAccountA), accountSetMemberNode(testSetId1)}},
+		testSetId1:    {{accountSetMemberNode(testSetId2)}},
+		testSetId2:    {{accountSetMemberNode(testSetId3)}},
+		testSetId3:    {{accountMemberNode(testAccountB)}},
+	}
+
+	_, diags := readAccountSetMembers(t, accountSetMembersClient(chain), accountSetMembersConfig(true, types.Int64Value(3)))
+	if !diags.HasError() || diags[0].Summary() != "Maximum Depth Exceeded" || !strings.Contains(diags[0].Detail(), testSetId3) {
+		t.Fatalf("expected account set %s to exceed max_depth, got %v", testSetId3, diags)
+	}
+
+	data, diags := readAccountSetMembers(t, accountSetMembersClient(chain), accountSetMembersConfig(true, types.Int64Value(4)))
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	expected := []string{testAccountA + "@" + testRootSetId + "/1", testAccountB + "@" + testSetId3 + "/4"}
+	if accounts := accountSetMemberAccounts(data.Accounts); strings.Join(accounts, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected accounts %v, got %v", expected, accounts)
+	}
+
+	// Without recursive nested sets are not expanded, whatever their depth.
+	data, diags = readAccountSetMembers(t, accountSetMembersClient(chain), accountSetMembersConfig(false, types.Int64Value(1)))
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if accounts := accountSetMemberAccounts(data.Accounts); len(accounts) != 1 {
+		t.Errorf("expected only the direct account, got %v", accounts)
+	}
+}
+
+func TestAccountSetMembersPagination(t *testing.T) {
+	client := accountSetMembersClient(map[string][][]string{
+		testRootSetId: {
+			{accountMemberNode(testAccountA), accountSetMemberNode(testSetId1)},
+			{accountMemberNode(testAccountB)},
+		},
+		testSetId1: {
+			{accountMemberNode(testAccountA)},
+			{},
+			{accountMemberNode(testAccountC)},
+		},
+	})
+
+	data, diags := readAccountSetMembers(t, client, accountSetMembersConfig(true, types.Int64Null()))
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if len(data.Members) != 3 {
+		t.Errorf("expected the direct members of both pages, got %v", data.Members)
+	}
+
+	expected := []string{
+		testAccountA + "@" + testRootSetId + "/1",
+		testAccountB + "@" + testRootSetId + "/1",
+		testAccountC + "@" + testSetId1 + "/2",
+	}
+
+	if accounts := accountSetMemberAccounts(data.Accounts); strings.Join(accounts, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected accounts %v, got %v", expected, accounts)
+	}
+
+	if len(client.requests) != 5 {
+		t.Fatalf("expected every page to be read, got %v", client.operations)
+	}
+
+	if after := client.requests[1].Variables.(*__accountSetMembersListInput).After; after == nil || *after != "1" {
+		t.Errorf("expected the second page to be read after the first cursor, got %v", after)
+	}
+
+	_, diags = readAccountSetMembers(t, accountSetMembersClient(nil), accountSetMembersConfig(false, types.Int64Null()))
+	if !diags.HasError() || diags[0].Summary() != "Account Set Not Found" {
+		t.Errorf("expected a missing account set to be reported, got %v", diags)
+	}
+}
@@ -0,0 +1,332 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &AccountsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &AccountsDataSource{}
+
+const defaultAccountsPageSize = 100
+
+func NewAccountsDataSource() datasource.DataSource {
+	return &AccountsDataSource{}
+}
+
+type AccountsDataSource struct {
+	client *graphql.Client
+}
+
+type AccountsDataSourceModel struct {
+	PageSize          types.Int64          `tfsdk:"page_size"`
+	CodePrefix        types.String         `tfsdk:"code_prefix"`
+	CodeRegex         types.String         `tfsdk:"code_regex"`
+	Status            types.String         `tfsdk:"status"`
+	NormalBalanceType types.String         `tfsdk:"normal_balance_type"`
+	HasExternalId     types.Bool           `tfsdk:"has_external_id"`
+	Metadata          types.Map            `tfsdk:"metadata"`
+	Accounts          []AccountsEntryModel `tfsdk:"accounts"`
+}
+
+type AccountsEntryModel struct {
+	AccountId         UUIDValue      `tfsdk:"id"`
+	Code              types.String   `tfsdk:"code"`
+	Name              types.String   `tfsdk:"name"`
+	NormalBalanceType types.String   `tfsdk:"normal_balance_type"`
+	Status            types.String   `tfsdk:"status"`
+	ExternalId        types.String   `tfsdk:"external_id"`
+	Description       types.String   `tfsdk:"description"`
+	Metadata          types.String   `tfsdk:"metadata"`
+	CreatedAt         TimestampValue `tfsdk:"created_at"`
+	ModifiedAt        TimestampValue `tfsdk:"modified_at"`
+}
+
+func (d *AccountsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_accounts"
+}
+
+func (d *AccountsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "All cala accounts matching the given filters. Every page of accounts is read, the filters are " +
+			"applied by the provider.",
+		Attributes: map[string]schema.Attribute{
+			"page_size": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Number of accounts requested per page. Defaults to %d.", defaultAccountsPageSize),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1000),
+				},
+			},
+			"code_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only return accounts whose code starts with this prefix.",
+				Optional:            true,
+			},
+			"code_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return accounts whose code matches this regular expression, in RE2 syntax.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return accounts with this status, `ACTIVE` or `LOCKED`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ACTIVE", "LOCKED"),
+				},
+			},
+			"normal_balance_type": schema.StringAttribute{
+				MarkdownDescription: "Only return accounts with this normal balance type, `DEBIT` or `CREDIT`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("DEBIT", "CREDIT"),
+				},
+			},
+			"has_external_id": schema.BoolAttribute{
+				MarkdownDescription: "When true only return accounts with an external ID, when false only those without.",
+				Optional:            true,
+			},
+			"metadata": schema.MapAttribute{
+				MarkdownDescription: "Only return accounts whose metadata has all of these keys with these values. Values " +
+					"that are not strings are compared to their JSON encoding.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"accounts": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching accounts.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							CustomType:          UUIDType{},
+							MarkdownDescription: "ID of the account.",
+							Computed:            true,
+						},
+						"code": schema.StringAttribute{
+							MarkdownDescription: "Code of the account.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the account.",
+							Computed:            true,
+						},
+						"normal_balance_type": schema.StringAttribute{
+							MarkdownDescription: "Normal balance type of the account.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the account.",
+							Computed:            true,
+						},
+						"external_id": schema.StringAttribute{
+							MarkdownDescription: "External ID of the account.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the account.",
+							Computed:            true,
+						},
+						"metadata": schema.StringAttribute{
+							MarkdownDescription: "Metadata of the account, JSON encoded.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							CustomType:          TimestampType{},
+							MarkdownDescription: "Time the account was created, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"modified_at": schema.StringAttribute{
+							CustomType:          TimestampType{},
+							MarkdownDescription: "Time the account was last modified, in RFC 3339 format.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AccountsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var codeRegex types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("code_regex"), &codeRegex)...)
+
+	if codeRegex.IsNull() || codeRegex.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(codeRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("code_regex"), "Invalid Regular Expression", err.Error())
+	}
+}
+
+func (d *AccountsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*CalaProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CalaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = &providerData.Client
+}
+
+func (d *AccountsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AccountsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pageSize := defaultAccountsPageSize
+	if !data.PageSize.IsNull() {
+		pageSize = int(data.PageSize.ValueInt64())
+	}
+
+	var codeRegex *regexp.Regexp
+	if !data.CodeRegex.IsNull() {
+		var err error
+		codeRegex, err = regexp.Compile(data.CodeRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("code_regex"), "Invalid Regular Expression", err.Error())
+			return
+		}
+	}
+
+	metadata := map[string]string{}
+	resp.Diagnostics.Append(data.Metadata.ElementsAs(ctx, &metadata, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	matches := func(account *accountsListAccountsAccountConnectionNodesAccount) bool {
+		if !data.CodePrefix.IsNull() && !strings.HasPrefix(account.Code, data.CodePrefix.ValueString()) {
+			return false
+		}
+		if codeRegex != nil && !codeRegex.MatchString(account.Code) {
+			return false
+		}
+		if !data.Status.IsNull() && string(account.Status) != data.Status.ValueString() {
+			return false
+		}
+		if !data.NormalBalanceType.IsNull() && string(account.NormalBalanceType) != data.NormalBalanceType.ValueString() {
+			return false
+		}
+		if !data.HasExternalId.IsNull() && (account.ExternalId != nil) != data.HasExternalId.ValueBool() {
+			return false
+		}
+		return metadataMatches(account.Metadata, metadata)
+	}
+
+	data.Accounts = []AccountsEntryModel{}
+
+	var after *string
+	pages := 0
+
+	for {
+		response, err := accountsList(ctx, *d.client, pageSize, after)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list accounts, got error: %s", err))
+			return
+		}
+		pages++
+
+		for i := range response.Accounts.Nodes {
+			account := &response.Accounts.Nodes[i]
+			if !matches(account) {
+				continue
+			}
+
+			entry := AccountsEntryModel{
+				AccountId:         NewUUIDValue(account.AccountId),
+				Code:              types.StringValue(account.Code),
+				Name:              types.StringValue(account.Name),
+				NormalBalanceType: types.StringValue(string(account.NormalBalanceType)),
+				Status:            types.StringValue(string(account.Status)),
+				ExternalId:        types.StringPointerValue(account.ExternalId),
+				Description:       types.StringPointerValue(account.Description),
+				Metadata:          types.StringNull(),
+				CreatedAt:         NewTimestampValue(account.CreatedAt),
+				ModifiedAt:        NewTimestampValue(account.ModifiedAt),
+			}
+			if account.Metadata != nil {
+				entry.Metadata = types.StringValue(string(*account.Metadata))
+			}
+
+			data.Accounts = append(data.Accounts, entry)
+		}
+
+		pageInfo := response.Accounts.PageInfo
+		if !pageInfo.HasNextPage || pageInfo.EndCursor == nil {
+			break
+		}
+		after = pageInfo.EndCursor
+	}
+
+	tflog.Trace(ctx, "listed accounts", map[string]interface{}{"pages": pages, "matches": len(data.Accounts)})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// metadataMatches reports whether the top level keys of metadata have the
+// wanted values. Values that are not strings are compared to their JSON
+// encoding.
+func metadataMatches(metadata *json.RawMessage, wanted map[string]string) bool {
+	if len(wanted) == 0 {
+		return true
+	}
+
+	if metadata == nil {
+		return false
+	}
+
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(*metadata, &values); err != nil {
+		return false
+	}
+
+	for key, want := range wanted {
+		raw, ok := values[key]
+		if !ok {
+			return false
+		}
+
+		var s string
+		if err := json.Unmarshal(raw, &s); err == nil {
+			if s != want {
+				return false
+			}
+			continue
+		}
+
+		if string(raw) != want {
+			return false
+		}
+	}
+
+	return true
+}
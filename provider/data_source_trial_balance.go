@@ -0,0 +1,355 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/shopspring/decimal"
+)
+
+var _ datasource.DataSource = &TrialBalanceDataSource{}
+
+const defaultTrialBalanceConcurrency = 8
+
+func NewTrialBalanceDataSource() datasource.DataSource {
+	return &TrialBalanceDataSource{}
+}
+
+type TrialBalanceDataSource struct {
+	client   *graphql.Client
+	defaults CalaProviderDefaults
+}
+
+type TrialBalanceDataSourceModel struct {
+	JournalId    UUIDValue                  `tfsdk:"journal_id"`
+	Currency     CurrencyCodeValue          `tfsdk:"currency"`
+	Layer        types.String               `tfsdk:"layer"`
+	AccountIds   []UUIDValue                `tfsdk:"account_ids"`
+	Concurrency  types.Int64                `tfsdk:"concurrency"`
+	TotalDebits  DecimalValue               `tfsdk:"total_debits"`
+	TotalCredits DecimalValue               `tfsdk:"total_credits"`
+	Balanced     types.Bool                 `tfsdk:"balanced"`
+	Balances     []TrialBalanceAccountModel `tfsdk:"balances"`
+}
+
+type TrialBalanceAccountModel struct {
+	AccountId     UUIDValue    `tfsdk:"account_id"`
+	DrBalance     DecimalValue `tfsdk:"dr_balance"`
+	CrBalance     DecimalValue `tfsdk:"cr_balance"`
+	NormalBalance DecimalValue `tfsdk:"normal_balance"`
+}
+
+func (d *TrialBalanceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trial_balance"
+}
+
+func (d *TrialBalanceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Trial balance of a cala journal in one currency. Sums the debits and credits of every account " +
+			"so `check` blocks can assert that the books balance.",
+		Attributes: map[string]schema.Attribute{
+			"journal_id": schema.StringAttribute{
+				CustomType:          UUIDType{},
+				MarkdownDescription: "ID of the journal. Defaults to the `default_journal_id` of the provider.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"currency": schema.StringAttribute{
+				CustomType:          CurrencyCodeType{},
+				MarkdownDescription: "Currency of the balances. Defaults to the `default_currency` of the provider.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"layer": schema.StringAttribute{
+				MarkdownDescription: "Layer that is summed, `SETTLED`, `PENDING` or `ENCUMBRANCE`. Defaults to `SETTLED`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("SETTLED", "PENDING", "ENCUMBRANCE"),
+				},
+			},
+			"account_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the accounts to include. Defaults to every account, discovered by reading all pages " +
+					"of accounts. Each account can only be listed once, IDs differing only in case are the same account.",
+				ElementType: UUIDType{},
+				Optional:    true,
+				Computed:    true,
+			},
+			"concurrency": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of balances read at the same time. Defaults to %d.", defaultTrialBalanceConcurrency),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 64),
+				},
+			},
+			"total_debits": schema.StringAttribute{
+				CustomType:          DecimalType{},
+				MarkdownDescription: "Sum of the debits of all accounts, as a decimal string.",
+				Computed:            true,
+			},
+			"total_credits": schema.StringAttribute{
+				CustomType:          DecimalType{},
+				MarkdownDescription: "Sum of the credits of all accounts, as a decimal string.",
+				Computed:            true,
+			},
+			"balanced": schema.BoolAttribute{
+				MarkdownDescription: "Whether `total_debits` equals `total_credits`.",
+				Computed:            true,
+			},
+			"balances": schema.ListNestedAttribute{
+				MarkdownDescription: "Balance of each account with entries in the currency, in the order of `account_ids`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"account_id": schema.StringAttribute{
+							CustomType:          UUIDType{},
+							MarkdownDescription: "ID of the account.",
+							Computed:            true,
+						},
+						"dr_balance": schema.StringAttribute{
+							CustomType:          DecimalType{},
+							MarkdownDescription: "Sum of the debits, as a decimal string.",
+							Computed:            true,
+						},
+						"cr_balance": schema.StringAttribute{
+							CustomType:          DecimalType{},
+							MarkdownDescription: "Sum of the credits, as a decimal string.",
+							Computed:            true,
+						},
+						"normal_balance": schema.StringAttribute{
+							CustomType:          DecimalType{},
+							MarkdownDescription: "Balance in the direction of the normal balance type, as a decimal string.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TrialBalanceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*CalaProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CalaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = &providerData.Client
+	d.defaults = providerData.Defaults
+}
+
+func (d *TrialBalanceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TrialBalanceDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.JournalId.IsNull() {
+		if d.defaults.JournalId == "" {
+			resp.Diagnostics.AddError("Missing Journal", "Set journal_id on the data source or default_journal_id on the provider.")
+			return
+		}
+		data.JournalId = NewUUIDValue(d.defaults.JournalId)
+	}
+
+	if data.Currency.IsNull() {
+		if d.defaults.Currency == "" {
+			resp.Diagnostics.AddError("Missing Currency", "Set currency on the data source or default_currency on the provider.")
+			return
+		}
+		data.Currency = NewCurrencyCodeValue(d.defaults.Currency)
+	}
+
+	if data.Layer.IsNull() {
+		data.Layer = types.StringValue("SETTLED")
+	}
+
+	if data.AccountIds == nil {
+		accountIds, err := d.listAccountIds(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list accounts, got error: %s", err))
+			return
+		}
+		data.AccountIds = accountIds
+	}
+
+	// IDs differing only in case are the same account, which would be
+	// counted twice.
+	listed := make(map[string]int, len(data.AccountIds))
+	for i, accountId := range data.AccountIds {
+		key := strings.ToLower(accountId.ValueString())
+		if j, ok := listed[key]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("account_ids").AtListIndex(i),
+				"Duplicate Account ID",
+				fmt.Sprintf("Account %s is already listed at index %d of account_ids.", accountId.ValueString(), j),
+			)
+			return
+		}
+		listed[key] = i
+	}
+
+	concurrency := defaultTrialBalanceConcurrency
+	if !data.Concurrency.IsNull() {
+		concurrency = int(data.Concurrency.ValueInt64())
+	}
+
+	balances, err := d.readBalances(ctx, &data, concurrency)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read balance, got error: %s", err))
+		return
+	}
+
+	totalDebits, totalCredits := decimal.Zero, decimal.Zero
+	data.Balances = []TrialBalanceAccountModel{}
+
+	for i, balance := range balances {
+		// Accounts without entries in the currency have no balance.
+		if balance == nil {
+			continue
+		}
+
+		var amount *balanceAmountFields
+		switch data.Layer.ValueString() {
+		case "PENDING":
+			amount = &balance.Pending.balanceAmountFields
+		case "ENCUMBRANCE":
+			amount = &balance.Encumbrance.balanceAmountFields
+		default:
+			amount = &balance.Settled.balanceAmountFields
+		}
+
+		debits, err := decimal.NewFromString(amount.DrBalance.Units)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Balance", fmt.Sprintf("Unable to parse the debits of account %s: %s", data.AccountIds[i].ValueString(), err))
+			return
+		}
+		credits, err := decimal.NewFromString(amount.CrBalance.Units)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Balance", fmt.Sprintf("Unable to parse the credits of account %s: %s", data.AccountIds[i].ValueString(), err))
+			return
+		}
+
+		totalDebits = totalDebits.Add(debits)
+		totalCredits = totalCredits.Add(credits)
+
+		data.Balances = append(data.Balances, TrialBalanceAccountModel{
+			AccountId:     data.AccountIds[i],
+			DrBalance:     NewDecimalValue(amount.DrBalance.Units),
+			CrBalance:     NewDecimalValue(amount.CrBalance.Units),
+			NormalBalance: NewDecimalValue(amount.NormalBalance.Units),
+		})
+	}
+
+	tflog.Trace(ctx, "read a trial balance", map[string]interface{}{"accounts": len(data.AccountIds)})
+
+	data.TotalDebits = NewDecimalValue(totalDebits.String())
+	data.TotalCredits = NewDecimalValue(totalCredits.String())
+	data.Balanced = types.BoolValue(totalDebits.Equal(totalCredits))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listAccountIds reads every page of accounts.
+func (d *TrialBalanceDataSource) listAccountIds(ctx context.Context) ([]UUIDValue, error) {
+	accountIds := []UUIDValue{}
+
+	var after *string
+
+	for {
+		response, err := accountsList(ctx, *d.client, defaultAccountsPageSize, after)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, account := range response.Accounts.Nodes {
+			accountIds = append(accountIds, NewUUIDValue(account.AccountId))
+		}
+
+		pageInfo := response.Accounts.PageInfo
+		if !pageInfo.HasNextPage || pageInfo.EndCursor == nil {
+			return accountIds, nil
+		}
+		after = pageInfo.EndCursor
+	}
+}
+
+// readBalances reads the balance of every account with at most concurrency
+// requests in flight. The balances are returned in the order of the account
+// IDs, nil for accounts without entries in the currency.
+func (d *TrialBalanceDataSource) readBalances(ctx context.Context, data *TrialBalanceDataSourceModel, concurrency int) ([]*balanceFields, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	balances := make([]*balanceFields, len(data.AccountIds))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				accountId := data.AccountIds[i].ValueString()
+				response, err := balanceGet(ctx, *d.client, data.JournalId.ValueString(), accountId, data.Currency.ValueString())
+				if err != nil {
+					once.Do(func() {
+						firstErr = fmt.Errorf("account %s: %w", accountId, err)
+						cancel()
+					})
+					continue
+				}
+				if response.Balance != nil {
+					balances[i] = &response.Balance.balanceFields
+				}
+			}
+		}()
+	}
+
+	for i := range data.AccountIds {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	close(indexes)
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	return balances, ctx.Err()
+}
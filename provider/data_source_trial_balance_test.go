@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// balanceClient answers balanceGet with the balance read returns for the
+// account, a nil balance when read returns an empty string.
+type balanceClient struct {
+	read func(ctx context.Context, accountId string) (string, error)
+}
+
+func (c *balanceClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	variables, ok := req.Variables.(*__balanceGetInput)
+	if !ok {
+		return fmt.Errorf("unexpected %s request", req.OpName)
+	}
+
+	balance, err := c.read(ctx, variables.AccountId)
+	if err != nil {
+		return err
+	}
+	if balance == "" {
+		balance = "null"
+	}
+
+	return json.Unmarshal([]byte(`{"balance": `+balance+`}`), resp.Data)
+}
+
+func testAccountIds(n int) []UUIDValue {
+	accountIds := make([]UUIDValue, n)
+	for i := range accountIds {
+		accountIds[i] = NewUUIDValue(fmt.Sprintf("20000000-0000-0000-0000-%012d", i))
+	}
+	return accountIds
+}
+
+// accountIndex is the index of an ID from testAccountIds.
+func accountIndex(accountId string) int {
+	i, _ := strconv.Atoi(accountId[len(accountId)-12:])
+	return i
+}
+
+func newTrialBalanceDataSource(client graphql.Client) *TrialBalanceDataSource {
+	return &TrialBalanceDataSource{client: &client}
+}
+
+func TestReadBalances(t *testing.T) {
+	const concurrency = 3
+
+	var inFlight, maxInFlight atomic.Int32
+
+	d := newTrialBalanceDataSource(&balanceClient{read: func(ctx context.Context, accountId string) (string, error) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			max := maxInFlight.Load()
+			if current <= max || maxInFlight.CompareAndSwap(max, current) {
+				break
+			}
+		}
+
+		// Later accounts answer first, so results arrive out of order.
+		i := accountIndex(accountId)
+		time.Sleep(time.Duration(20-i) * time.Millisecond)
+
+		if i%4 == 3 {
+			return "", nil
+		}
+		return testBalanceJson(fmt.Sprint(i), "0", fmt.Sprint(i)), nil
+	}})
+
+	data := &TrialBalanceDataSourceModel{
+		JournalId:  NewUUIDValue(testBalanceJournalId),
+		Currency:   NewCurrencyCodeValue("USD"),
+		AccountIds: testAccountIds(20),
+	}
+
+	balances, err := d.readBalances(context.Background(), data, concurrency)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(balances) != 20 {
+		t.Fatalf("expected a balance per account, got %d", len(balances))
+	}
+
+	for i, balance := range balances {
+		if i%4 == 3 {
+			if balance != nil {
+				t.Errorf("%d: expected no balance, got %+v", i, balance)
+			}
+			continue
+		}
+		if balance == nil || balance.Settled.DrBalance.Units != fmt.Sprint(i) {
+			t.Errorf("%d: expected the balance of account %d, got %+v", i, i, balance)
+		}
+	}
+
+	if max := maxInFlight.Load(); max > concurrency || max < 2 {
+		t.Errorf("expected up to %d balances read at the same time, got %d", concurrency, max)
+	}
+}
+
+func TestReadBalancesCancel(t *testing.T) {
+	var started atomic.Int32
+	var canceled sync.WaitGroup
+
+	d := newTrialBalanceDataSource(&balanceClient{read: func(ctx context.Context, accountId string) (string, error) {
+		started.Add(1)
+
+		if accountIndex(accountId) == 0 {
+			return "", errors.New("server unavailable")
+		}
+
+		// Other reads only finish once the first error cancels them.
+		canceled.Add(1)
+		defer canceled.Done()
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(5 * time.Second):
+			return "", errors.New("not canceled")
+		}
+	}})
+
+	data := &TrialBalanceDataSourceModel{
+		JournalId:  NewUUIDValue(testBalanceJournalId),
+		Currency:   NewCurrencyCodeValue("USD"),
+		AccountIds: testAccountIds(50),
+	}
+
+	begin := time.Now()
+	_, err := d.readBalances(context.Background(), data, 4)
+
+	if err == nil || !strings.Contains(err.Error(), data.AccountIds[0].ValueString()) || !strings.Contains(err.Error(), "server unavailable") {
+		t.Fatalf("expected the first error to be returned, got %v", err)
+	}
+
+	if elapsed := time.Since(begin); elapsed > 2*time.Second {
+		t.Errorf("expected the pending reads to be canceled, took %s", elapsed)
+	}
+
+	if n := started.Load(); n >= 50 {
+		t.Errorf("expected the remaining accounts not to be read, got %d reads", n)
+	}
+
+	canceled.Wait()
+}
+
+func readTrialBalance(t *testing.T, client graphql.Client, accountIds []UUIDValue) (*TrialBalanceDataSourceModel, diag.Diagnostics) {
+	t.Helper()
+
+	ctx := context.Background()
+	d := newTrialBalanceDataSource(client)
+	d.defaults = CalaProviderDefaults{JournalId: testBalanceJournalId, Currency: "USD"}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	null := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	config := TrialBalanceDataSourceModel{
+		JournalId:    NewUUIDNull(),
+		Currency:     NewCurrencyCodeNull(),
+		Layer:        types.StringNull(),
+		AccountIds:   accountIds,
+		Concurrency:  types.Int64Value(2),
+		TotalDebits:  NewDecimalUnknown(),
+		TotalCredits: NewDecimalUnknown(),
+		Balanced:     types.BoolUnknown(),
+	}
+
+	configState := tfsdk.State{Schema: schemaResp.Schema, Raw: null}
+	if diags := configState.Set(ctx, &config); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	req := datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: configState.Raw}}
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: null}}
+	d.Read(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		return nil, resp.Diagnostics
+	}
+
+	var data *TrialBalanceDataSourceModel
+	if diags := resp.State.Get(ctx, &data); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	return data, resp.Diagnostics
+}
+
+func TestTrialBalanceDataSource(t *testing.T) {
+	client := &balanceClient{read: func(ctx context.Context, accountId string) (string, error) {
+		switch accountIndex(accountId) {
+		case 0:
+			return testBalanceJson("100", "0", "100"), nil
+		case 1:
+			return testBalanceJson("0", "60", "60"), nil
+		case 2:
+			return "", nil
+		case 3:
+			return testBalanceJson("0", "40", "40"), nil
+		}
+		return "", errors.New("server unavailable")
+	}}
+
+	accountIds := testAccountIds(4)
+	// Balances are reported in the order of account_ids, not of the IDs.
+	ordered := []UUIDValue{accountIds[3], accountIds[2], accountIds[0], accountIds[1]}
+
+	data, diags := readTrialBalance(t, client, ordered)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	var reported []string
+	for _, balance := range data.Balances {
+		reported = append(reported, balance.AccountId.ValueString())
+	}
+
+	expected := []string{accountIds[3].ValueString(), accountIds[0].ValueString(), accountIds[1].ValueString()}
+	if strings.Join(reported, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected balances of %v, got %v", expected, reported)
+	}
+
+	if data.TotalDebits.ValueString() != "100" || data.TotalCredits.ValueString() != "100" || !data.Balanced.ValueBool() {
+		t.Errorf("expected balanced totals of 100, got %s and %s", data.TotalDebits, data.TotalCredits)
+	}
+
+	// An account listed twice, whatever the case of its ID, is refused.
+	upper := NewUUIDValue(strings.ToUpper(accountIds[0].ValueString()))
+	_, diags = readTrialBalance(t, client, []UUIDValue{accountIds[0], accountIds[1], upper})
+	if !diags.HasError() || diags[0].Summary() != "Duplicate Account ID" || !strings.Contains(diags[0].Detail(), "index 0") {
+		t.Errorf("expected a duplicate account ID error, got %v", diags)
+	}
+
+	_, diags = readTrialBalance(t, client, testAccountIds(6))
+	if !diags.HasError() || !strings.Contains(diags[0].Detail(), "server unavailable") {
+		t.Errorf("expected the read error to be reported, got %v", diags)
+	}
+}
@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &TxTemplateDataSource{}
+
+func NewTxTemplateDataSource() datasource.DataSource {
+	return &TxTemplateDataSource{}
+}
+
+type TxTemplateDataSource struct {
+	client *graphql.Client
+}
+
+type TxTemplateDataSourceModel struct {
+	TxTemplateId UUIDValue                   `tfsdk:"id"`
+	Code         types.String                `tfsdk:"code"`
+	Version      types.Int64                 `tfsdk:"version"`
+	Description  types.String                `tfsdk:"description"`
+	Params       []TxTemplateParamModel      `tfsdk:"params"`
+	Transaction  *TxTemplateTransactionModel `tfsdk:"transaction"`
+	Entries      []TxTemplateEntryModel      `tfsdk:"entries"`
+}
+
+type TxTemplateParamModel struct {
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Default     types.String `tfsdk:"default"`
+	Description types.String `tfsdk:"description"`
+}
+
+type TxTemplateTransactionModel struct {
+	Effective     types.String `tfsdk:"effective"`
+	JournalId     types.String `tfsdk:"journal_id"`
+	CorrelationId types.String `tfsdk:"correlation_id"`
+	ExternalId    types.String `tfsdk:"external_id"`
+	Description   types.String `tfsdk:"description"`
+	Metadata      types.String `tfsdk:"metadata"`
+}
+
+type TxTemplateEntryModel struct {
+	EntryType   types.String `tfsdk:"entry_type"`
+	AccountId   types.String `tfsdk:"account_id"`
+	Layer       types.String `tfsdk:"layer"`
+	Direction   types.String `tfsdk:"direction"`
+	Units       types.String `tfsdk:"units"`
+	Currency    types.String `tfsdk:"currency"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (d *TxTemplateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tx_template"
+}
+
+func (d *TxTemplateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Cala tx template, looked up by ID or code.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				CustomType:          UUIDType{},
+				MarkdownDescription: "ID of the tx template. Exactly one of `id` and `code` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("code")),
+				},
+			},
+			"code": schema.StringAttribute{
+				MarkdownDescription: "Code of the tx template. Exactly one of `id` and `code` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the tx template.",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the tx template.",
+				Computed:            true,
+			},
+			"params": schema.ListNestedAttribute{
+				MarkdownDescription: "Params declared by the tx template.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the param.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "ParamDataType of the param.",
+							Computed:            true,
+						},
+						"default": schema.StringAttribute{
+							MarkdownDescription: "Default expression of the param.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the param.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"transaction": schema.SingleNestedAttribute{
+				MarkdownDescription: "Expressions used to build the transaction.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"effective": schema.StringAttribute{
+						MarkdownDescription: "Expression for the effective date.",
+						Computed:            true,
+					},
+					"journal_id": schema.StringAttribute{
+						MarkdownDescription: "Expression for the journal ID.",
+						Computed:            true,
+					},
+					"correlation_id": schema.StringAttribute{
+						MarkdownDescription: "Expression for the correlation ID.",
+						Computed:            true,
+					},
+					"external_id": schema.StringAttribute{
+						MarkdownDescription: "Expression for the external ID.",
+						Computed:            true,
+					},
+					"description": schema.StringAttribute{
+						MarkdownDescription: "Expression for the description.",
+						Computed:            true,
+					},
+					"metadata": schema.StringAttribute{
+						MarkdownDescription: "Expression for the metadata.",
+						Computed:            true,
+					},
+				},
+			},
+			"entries": schema.ListNestedAttribute{
+				MarkdownDescription: "Expressions used to build each entry of the transaction.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"entry_type": schema.StringAttribute{
+							MarkdownDescription: "Expression for the entry type.",
+							Computed:            true,
+						},
+						"account_id": schema.StringAttribute{
+							MarkdownDescription: "Expression for the account ID.",
+							Computed:            true,
+						},
+						"layer": schema.StringAttribute{
+							MarkdownDescription: "Expression for the layer.",
+							Computed:            true,
+						},
+						"direction": schema.StringAttribute{
+							MarkdownDescription: "Expression for the direction.",
+							Computed:            true,
+						},
+						"units": schema.StringAttribute{
+							MarkdownDescription: "Expression for the units.",
+							Computed:            true,
+						},
+						"currency": schema.StringAttribute{
+							MarkdownDescription: "Expression for the currency.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Expression for the description.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TxTemplateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*CalaProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CalaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = &providerData.Client
+}
+
+func (d *TxTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TxTemplateDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var txTemplate *txTemplateFields
+
+	if !data.TxTemplateId.IsNull() {
+		response, err := txTemplateGet(ctx, *d.client, data.TxTemplateId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read tx template, got error: %s", err))
+			return
+		}
+		if response.TxTemplate != nil {
+			txTemplate = &response.TxTemplate.txTemplateFields
+		}
+	} else {
+		response, err := txTemplateGetByCode(ctx, *d.client, data.Code.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read tx template, got error: %s", err))
+			return
+		}
+		if response.TxTemplateByCode != nil {
+			txTemplate = &response.TxTemplateByCode.txTemplateFields
+		}
+	}
+
+	if txTemplate == nil {
+		resp.Diagnostics.AddError("Tx Template Not Found", fmt.Sprintf("No tx template found for id %q / code %q.", data.TxTemplateId.ValueString(), data.Code.ValueString()))
+		return
+	}
+
+	tflog.Trace(ctx, "read a tx template")
+
+	data.TxTemplateId = NewUUIDValue(txTemplate.TxTemplateId)
+	data.Code = types.StringValue(txTemplate.Code)
+	data.Version = types.Int64Value(int64(txTemplate.Version))
+	data.Description = types.StringPointerValue(txTemplate.Description)
+
+	data.Params = flattenTxTemplateParams(txTemplate)
+	data.Transaction = flattenTxTemplateTransaction(txTemplate)
+	data.Entries = flattenTxTemplateEntries(txTemplate)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func flattenTxTemplateParams(txTemplate *txTemplateFields) []TxTemplateParamModel {
+	params := make([]TxTemplateParamModel, 0, len(txTemplate.Params))
+
+	for _, param := range txTemplate.Params {
+		params = append(params, TxTemplateParamModel{
+			Name:        types.StringValue(param.Name),
+			Type:        types.StringValue(string(param.Type)),
+			Default:     types.StringPointerValue(param.Default),
+			Description: types.StringPointerValue(param.Description),
+		})
+	}
+
+	return params
+}
+
+func flattenTxTemplateTransaction(txTemplate *txTemplateFields) *TxTemplateTransactionModel {
+	return &TxTemplateTransactionModel{
+		Effective:     types.StringValue(txTemplate.Transaction.Effective),
+		JournalId:     types.StringValue(txTemplate.Transaction.JournalId),
+		CorrelationId: types.StringPointerValue(txTemplate.Transaction.CorrelationId),
+		ExternalId:    types.StringPointerValue(txTemplate.Transaction.ExternalId),
+		Description:   types.StringPointerValue(txTemplate.Transaction.Description),
+		Metadata:      types.StringPointerValue(txTemplate.Transaction.Metadata),
+	}
+}
+
+func flattenTxTemplateEntries(txTemplate *txTemplateFields) []TxTemplateEntryModel {
+	entries := make([]TxTemplateEntryModel, 0, len(txTemplate.Entries))
+
+	for _, entry := range txTemplate.Entries {
+		entries = append(entries, TxTemplateEntryModel{
+			EntryType:   types.StringValue(entry.EntryType),
+			AccountId:   types.StringValue(entry.AccountId),
+			Layer:       types.StringValue(entry.Layer),
+			Direction:   types.StringValue(entry.Direction),
+			Units:       types.StringValue(entry.Units),
+			Currency:    types.StringValue(entry.Currency),
+			Description: types.StringPointerValue(entry.Description),
+		})
+	}
+
+	return entries
+}
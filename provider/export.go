@@ -0,0 +1,663 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const exportPageSize = 100
+
+// ExportConfig configures Export.
+type ExportConfig struct {
+	Endpoint string
+	Headers  map[string]string
+	Version  string
+
+	// TLS and proxy settings, as the provider attributes of the same names.
+	// The client certificate and key are read from files.
+	CaCertFile         string
+	ClientCertFile     string
+	ClientKeyFile      string
+	InsecureSkipVerify bool
+	TlsServerName      string
+	ProxyUrl           string
+
+	// OutputDir is the directory the .tf files are written to, it must not
+	// contain files with the same names yet.
+	OutputDir string
+
+	// The API cannot list account sets, journals and integrations. Account
+	// sets and journals are discovered from the accounts, these IDs are
+	// exported in addition.
+	AccountSetIds          []string
+	JournalIds             []string
+	BitfinexIntegrationIds []string
+	BigQueryIntegrationIds []string
+}
+
+type exporter struct {
+	client graphql.Client
+
+	accounts      []accountsListAccountsAccountConnectionNodesAccount
+	accountSets   map[string]*accountSetGetAccountSet
+	memberships   []exportMembership
+	journals      map[string]*journalGetJournal
+	bitfinex      []*bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration
+	bigQuery      []*bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration
+	accountNames  map[string]string
+	setNames      map[string]string
+	journalNames  map[string]string
+	bitfinexNames map[string]string
+	bigQueryNames map[string]string
+}
+
+type exportMembership struct {
+	accountSetId string
+	memberId     string
+	memberIsSet  bool
+}
+
+// Export reads the ledger behind endpoint and writes Terraform configuration
+// managing it, with an import block for every resource. Accounts are found
+// by listing all of them, account sets by walking up from the accounts and
+// down through the members of every account set found.
+func Export(ctx context.Context, config ExportConfig) error {
+	if err := validateEndpoint(config.Endpoint); err != nil {
+		return err
+	}
+
+	tlsConfig := transportConfig{
+		caCertFile:         config.CaCertFile,
+		insecureSkipVerify: config.InsecureSkipVerify,
+		tlsServerName:      config.TlsServerName,
+		proxyUrl:           config.ProxyUrl,
+	}
+
+	if config.ClientCertFile != "" {
+		clientCert, err := os.ReadFile(config.ClientCertFile)
+		if err != nil {
+			return fmt.Errorf("unable to read the client certificate: %w", err)
+		}
+		tlsConfig.clientCert = string(clientCert)
+	}
+
+	if config.ClientKeyFile != "" {
+		clientKey, err := os.ReadFile(config.ClientKeyFile)
+		if err != nil {
+			return fmt.Errorf("unable to read the client key: %w", err)
+		}
+		tlsConfig.clientKey = string(clientKey)
+	}
+
+	transport, err := newTransport(tlsConfig)
+	if err != nil {
+		return err
+	}
+
+	httpClient := http.Client{
+		Transport: &authedTransport{
+			endpoint:  config.Endpoint,
+			userAgent: userAgent("", config.Version),
+			headers:   config.Headers,
+			wrapped:   transport,
+		},
+	}
+
+	return exportLedger(ctx, graphql.NewClient(config.Endpoint, &httpClient), config)
+}
+
+// exportLedger writes the configuration of the ledger read through client.
+func exportLedger(ctx context.Context, client graphql.Client, config ExportConfig) error {
+	e := &exporter{
+		client:      client,
+		accountSets: map[string]*accountSetGetAccountSet{},
+		journals:    map[string]*journalGetJournal{},
+	}
+
+	if err := e.readAccounts(ctx); err != nil {
+		return err
+	}
+
+	if err := e.readAccountSets(ctx, config.AccountSetIds); err != nil {
+		return err
+	}
+
+	if err := e.readIntegrations(ctx, config.BitfinexIntegrationIds, config.BigQueryIntegrationIds); err != nil {
+		return err
+	}
+
+	if err := e.readJournals(ctx, config.JournalIds); err != nil {
+		return err
+	}
+
+	e.assignNames()
+
+	files := map[string]*hclwrite.File{
+		"journals.tf":            e.journalsFile(),
+		"accounts.tf":            e.accountsFile(),
+		"account_sets.tf":        e.accountSetsFile(),
+		"account_set_members.tf": e.membershipsFile(),
+		"integrations.tf":        e.integrationsFile(),
+		"variables.tf":           e.variablesFile(),
+	}
+
+	// Check every file first so nothing is written when one exists.
+	for name := range files {
+		_, err := os.Stat(filepath.Join(config.OutputDir, name))
+		if err == nil {
+			return fmt.Errorf("%s already exists in %s", name, config.OutputDir)
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	for name, file := range files {
+		if len(file.Body().Blocks()) == 0 {
+			continue
+		}
+		if err := os.WriteFile(filepath.Join(config.OutputDir, name), file.Bytes(), 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *exporter) readAccounts(ctx context.Context) error {
+	var after *string
+
+	for {
+		response, err := accountsList(ctx, e.client, exportPageSize, after)
+		if err != nil {
+			return fmt.Errorf("unable to list accounts: %w", err)
+		}
+
+		e.accounts = append(e.accounts, response.Accounts.Nodes...)
+
+		pageInfo := response.Accounts.PageInfo
+		if !pageInfo.HasNextPage || pageInfo.EndCursor == nil {
+			return nil
+		}
+		after = pageInfo.EndCursor
+	}
+}
+
+// readAccountSets reads the account sets containing any account, their
+// parents and their nested account sets, recording every membership.
+func (e *exporter) readAccountSets(ctx context.Context, accountSetIds []string) error {
+	queue := append([]string{}, accountSetIds...)
+
+	for _, account := range e.accounts {
+		ids, err := e.accountSetsOfAccount(ctx, account.AccountId)
+		if err != nil {
+			return err
+		}
+		queue = append(queue, ids...)
+	}
+
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		if _, ok := e.accountSets[id]; ok {
+			continue
+		}
+
+		response, err := accountSetGet(ctx, e.client, id)
+		if err != nil {
+			return fmt.Errorf("unable to read account set %s: %w", id, err)
+		}
+		if response.AccountSet == nil {
+			return fmt.Errorf("account set %s not found", id)
+		}
+		e.accountSets[id] = response.AccountSet
+
+		parents, err := e.accountSetsOfAccountSet(ctx, id)
+		if err != nil {
+			return err
+		}
+		queue = append(queue, parents...)
+
+		members, err := e.accountSetMembers(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		for _, member := range members {
+			switch m := member.(type) {
+			case *accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccount:
+				e.memberships = append(e.memberships, exportMembership{accountSetId: id, memberId: m.AccountId})
+			case *accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSet:
+				e.memberships = append(e.memberships, exportMembership{accountSetId: id, memberId: m.AccountSetId, memberIsSet: true})
+				queue = append(queue, m.AccountSetId)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (e *exporter) accountSetsOfAccount(ctx context.Context, accountId string) ([]string, error) {
+	var ids []string
+	var after *string
+
+	for {
+		response, err := accountSetsOfAccount(ctx, e.client, accountId, exportPageSize, after)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list account sets of account %s: %w", accountId, err)
+		}
+		if response.Account == nil {
+			return ids, nil
+		}
+
+		for _, node := range response.Account.Sets.Nodes {
+			ids = append(ids, node.AccountSetId)
+		}
+
+		pageInfo := response.Account.Sets.PageInfo
+		if !pageInfo.HasNextPage || pageInfo.EndCursor == nil {
+			return ids, nil
+		}
+		after = pageInfo.EndCursor
+	}
+}
+
+func (e *exporter) accountSetsOfAccountSet(ctx context.Context, accountSetId string) ([]string, error) {
+	var ids []string
+	var after *string
+
+	for {
+		response, err := accountSetsOfAccountSet(ctx, e.client, accountSetId, exportPageSize, after)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list account sets of account set %s: %w", accountSetId, err)
+		}
+		if response.AccountSet == nil {
+			return ids, nil
+		}
+
+		for _, node := range response.AccountSet.Sets.Nodes {
+			ids = append(ids, node.AccountSetId)
+		}
+
+		pageInfo := response.AccountSet.Sets.PageInfo
+		if !pageInfo.HasNextPage || pageInfo.EndCursor == nil {
+			return ids, nil
+		}
+		after = pageInfo.EndCursor
+	}
+}
+
+func (e *exporter) accountSetMembers(ctx context.Context, accountSetId string) ([]accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSetMember, error) {
+	var members []accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSetMember
+	var after *string
+
+	for {
+		response, err := accountSetMembersList(ctx, e.client, accountSetId, exportPageSize, after)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list members of account set %s: %w", accountSetId, err)
+		}
+		if response.AccountSet == nil {
+			return members, nil
+		}
+
+		connection := response.AccountSet.Members
+		members = append(members, connection.Nodes...)
+
+		if !connection.PageInfo.HasNextPage || connection.PageInfo.EndCursor == nil {
+			return members, nil
+		}
+		after = connection.PageInfo.EndCursor
+	}
+}
+
+func (e *exporter) readIntegrations(ctx context.Context, bitfinexIds []string, bigQueryIds []string) error {
+	for _, id := range bitfinexIds {
+		response, err := bfxIntegrationGet(ctx, e.client, id)
+		if err != nil {
+			return fmt.Errorf("unable to read bitfinex integration %s: %w", id, err)
+		}
+		if response.Bitfinex.Integration == nil {
+			return fmt.Errorf("bitfinex integration %s not found", id)
+		}
+		e.bitfinex = append(e.bitfinex, response.Bitfinex.Integration)
+	}
+
+	for _, id := range bigQueryIds {
+		response, err := bigQueryIntegrationGet(ctx, e.client, id)
+		if err != nil {
+			return fmt.Errorf("unable to read bigquery integration %s: %w", id, err)
+		}
+		if response.BigQuery.Integration == nil {
+			return fmt.Errorf("bigquery integration %s not found", id)
+		}
+		e.bigQuery = append(e.bigQuery, response.BigQuery.Integration)
+	}
+
+	return nil
+}
+
+// readJournals reads the given journals and those referenced by the account
+// sets and integrations.
+func (e *exporter) readJournals(ctx context.Context, journalIds []string) error {
+	ids := append([]string{}, journalIds...)
+
+	for _, accountSet := range e.accountSets {
+		ids = append(ids, accountSet.JournalId)
+	}
+
+	for _, integration := range e.bitfinex {
+		ids = append(ids, integration.JournalId)
+	}
+
+	for _, id := range ids {
+		if _, ok := e.journals[id]; ok {
+			continue
+		}
+
+		response, err := journalGet(ctx, e.client, id)
+		if err != nil {
+			return fmt.Errorf("unable to read journal %s: %w", id, err)
+		}
+		if response.Journal == nil {
+			return fmt.Errorf("journal %s not found", id)
+		}
+		e.journals[id] = response.Journal
+	}
+
+	return nil
+}
+
+// assignNames derives resource names from account codes and the names of
+// account sets and journals. Duplicates get a numeric suffix in the order of
+// their IDs, so the names are the same on every export.
+func (e *exporter) assignNames() {
+	accountLabels := map[string]string{}
+	for _, account := range e.accounts {
+		accountLabels[account.AccountId] = account.Code
+	}
+	e.accountNames = uniqueResourceNames(accountLabels)
+
+	setLabels := map[string]string{}
+	for id, accountSet := range e.accountSets {
+		setLabels[id] = accountSet.Name
+	}
+	e.setNames = uniqueResourceNames(setLabels)
+
+	journalLabels := map[string]string{}
+	for id, journal := range e.journals {
+		journalLabels[id] = journal.Name
+	}
+	e.journalNames = uniqueResourceNames(journalLabels)
+
+	bitfinexLabels := map[string]string{}
+	for _, integration := range e.bitfinex {
+		bitfinexLabels[integration.IntegrationId] = integration.Name
+	}
+	e.bitfinexNames = uniqueResourceNames(bitfinexLabels)
+
+	bigQueryLabels := map[string]string{}
+	for _, integration := range e.bigQuery {
+		bigQueryLabels[integration.IntegrationId] = integration.Name
+	}
+	e.bigQueryNames = uniqueResourceNames(bigQueryLabels)
+}
+
+var resourceNameInvalidChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// resourceName turns a code or name into a valid Terraform resource name.
+func resourceName(label string) string {
+	name := strings.Trim(resourceNameInvalidChars.ReplaceAllString(strings.ToLower(label), "_"), "_")
+
+	if name == "" {
+		return "unnamed"
+	}
+
+	if name[0] >= '0' && name[0] <= '9' {
+		return "_" + name
+	}
+
+	return name
+}
+
+// uniqueResourceNames maps each ID to the resource name of its label.
+func uniqueResourceNames(labels map[string]string) map[string]string {
+	ids := make([]string, 0, len(labels))
+	for id := range labels {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if labels[ids[i]] != labels[ids[j]] {
+			return labels[ids[i]] < labels[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+
+	names := map[string]string{}
+	used := map[string]bool{}
+
+	for _, id := range ids {
+		base := resourceName(labels[id])
+		name := base
+		for n := 2; used[name]; n++ {
+			name = fmt.Sprintf("%s_%d", base, n)
+		}
+		used[name] = true
+		names[id] = name
+	}
+
+	return names
+}
+
+func sortedIds[V any](values map[string]V) []string {
+	ids := make([]string, 0, len(values))
+	for id := range values {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func resourceTraversal(resourceType, name, attribute string) hcl.Traversal {
+	traversal := hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: name},
+	}
+	if attribute != "" {
+		traversal = append(traversal, hcl.TraverseAttr{Name: attribute})
+	}
+	return traversal
+}
+
+// appendResource appends a resource block followed by the import block
+// adopting the existing object, and returns the body of the resource.
+func appendResource(body *hclwrite.Body, resourceType, name, importId string) *hclwrite.Body {
+	if len(body.Blocks()) > 0 {
+		body.AppendNewline()
+	}
+
+	resource := body.AppendNewBlock("resource", []string{resourceType, name}).Body()
+
+	body.AppendNewline()
+
+	imp := body.AppendNewBlock("import", nil).Body()
+	imp.SetAttributeTraversal("to", resourceTraversal(resourceType, name, ""))
+	imp.SetAttributeValue("id", cty.StringVal(importId))
+
+	return resource
+}
+
+func setOptionalString(body *hclwrite.Body, name string, value *string) {
+	if value != nil {
+		body.SetAttributeValue(name, cty.StringVal(*value))
+	}
+}
+
+func (e *exporter) journalsFile() *hclwrite.File {
+	file := hclwrite.NewEmptyFile()
+
+	for _, id := range sortedIds(e.journals) {
+		journal := e.journals[id]
+		resource := appendResource(file.Body(), "cala_journal", e.journalNames[id], id)
+		resource.SetAttributeValue("id", cty.StringVal(id))
+		resource.SetAttributeValue("name", cty.StringVal(journal.Name))
+		setOptionalString(resource, "description", journal.Description)
+	}
+
+	return file
+}
+
+func (e *exporter) accountsFile() *hclwrite.File {
+	file := hclwrite.NewEmptyFile()
+
+	accounts := append(e.accounts[:0:0], e.accounts...)
+	sort.Slice(accounts, func(i, j int) bool {
+		return e.accountNames[accounts[i].AccountId] < e.accountNames[accounts[j].AccountId]
+	})
+
+	for _, account := range accounts {
+		resource := appendResource(file.Body(), "cala_account", e.accountNames[account.AccountId], account.AccountId)
+		resource.SetAttributeValue("id", cty.StringVal(account.AccountId))
+		resource.SetAttributeValue("name", cty.StringVal(account.Name))
+		resource.SetAttributeValue("code", cty.StringVal(account.Code))
+		setOptionalString(resource, "description", account.Description)
+		resource.SetAttributeValue("normal_balance_type", cty.StringVal(string(account.NormalBalanceType)))
+		setOptionalString(resource, "external_id", account.ExternalId)
+		if account.Status != StatusActive {
+			resource.SetAttributeValue("status", cty.StringVal(string(account.Status)))
+		}
+	}
+
+	return file
+}
+
+func (e *exporter) accountSetsFile() *hclwrite.File {
+	file := hclwrite.NewEmptyFile()
+
+	ids := sortedIds(e.accountSets)
+	sort.Slice(ids, func(i, j int) bool { return e.setNames[ids[i]] < e.setNames[ids[j]] })
+
+	for _, id := range ids {
+		accountSet := e.accountSets[id]
+		resource := appendResource(file.Body(), "cala_account_set", e.setNames[id], id)
+		resource.SetAttributeValue("id", cty.StringVal(id))
+		resource.SetAttributeValue("name", cty.StringVal(accountSet.Name))
+		resource.SetAttributeTraversal("journal_id", resourceTraversal("cala_journal", e.journalNames[accountSet.JournalId], "id"))
+		setOptionalString(resource, "description", accountSet.Description)
+		resource.SetAttributeValue("normal_balance_type", cty.StringVal(string(accountSet.NormalBalanceType)))
+	}
+
+	return file
+}
+
+func (e *exporter) membershipsFile() *hclwrite.File {
+	file := hclwrite.NewEmptyFile()
+
+	type membership struct {
+		exportMembership
+		name string
+	}
+
+	labels := map[string]string{}
+	keys := map[string]exportMembership{}
+	for _, m := range e.memberships {
+		memberName := e.accountNames[m.memberId]
+		if m.memberIsSet {
+			memberName = e.setNames[m.memberId]
+		}
+		key := m.accountSetId + "/" + m.memberId
+		labels[key] = e.setNames[m.accountSetId] + "_" + memberName
+		keys[key] = m
+	}
+	names := uniqueResourceNames(labels)
+
+	memberships := make([]membership, 0, len(keys))
+	for key, m := range keys {
+		memberships = append(memberships, membership{m, names[key]})
+	}
+	sort.Slice(memberships, func(i, j int) bool { return memberships[i].name < memberships[j].name })
+
+	for _, m := range memberships {
+		if m.memberIsSet {
+			resource := appendResource(file.Body(), "cala_account_set_member_account_set", m.name,
+				fmt.Sprintf("account_set/%s/member_account_set/%s", m.accountSetId, m.memberId))
+			resource.SetAttributeTraversal("account_set_id", resourceTraversal("cala_account_set", e.setNames[m.accountSetId], "id"))
+			resource.SetAttributeTraversal("member_account_set_id", resourceTraversal("cala_account_set", e.setNames[m.memberId], "id"))
+			continue
+		}
+
+		resource := appendResource(file.Body(), "cala_account_set_member_account", m.name,
+			fmt.Sprintf("account_set/%s/account/%s", m.accountSetId, m.memberId))
+		resource.SetAttributeTraversal("account_set_id", resourceTraversal("cala_account_set", e.setNames[m.accountSetId], "id"))
+		resource.SetAttributeTraversal("member_account_id", resourceTraversal("cala_account", e.accountNames[m.memberId], "id"))
+	}
+
+	return file
+}
+
+// integrationsFile writes the integrations, their credentials cannot be read
+// back and are taken from the variables of variablesFile.
+func (e *exporter) integrationsFile() *hclwrite.File {
+	file := hclwrite.NewEmptyFile()
+
+	for _, integration := range e.bitfinex {
+		name := e.bitfinexNames[integration.IntegrationId]
+		resource := appendResource(file.Body(), "cala_bitfinex_integration", name, integration.IntegrationId)
+		resource.SetAttributeValue("id", cty.StringVal(integration.IntegrationId))
+		resource.SetAttributeValue("name", cty.StringVal(integration.Name))
+		setOptionalString(resource, "description", integration.Description)
+		resource.SetAttributeTraversal("journal_id", resourceTraversal("cala_journal", e.journalNames[integration.JournalId], "id"))
+		resource.SetAttributeTraversal("key", hcl.Traversal{hcl.TraverseRoot{Name: "var"}, hcl.TraverseAttr{Name: name + "_key"}})
+		resource.SetAttributeTraversal("secret", hcl.Traversal{hcl.TraverseRoot{Name: "var"}, hcl.TraverseAttr{Name: name + "_secret"}})
+	}
+
+	for _, integration := range e.bigQuery {
+		name := e.bigQueryNames[integration.IntegrationId]
+		resource := appendResource(file.Body(), "cala_big_query_integration", name, integration.IntegrationId)
+		resource.SetAttributeValue("id", cty.StringVal(integration.IntegrationId))
+		resource.SetAttributeValue("name", cty.StringVal(integration.Name))
+		setOptionalString(resource, "description", integration.Description)
+		resource.SetAttributeValue("project_id", cty.StringVal(integration.GcpProjectId))
+		resource.SetAttributeValue("dataset_id", cty.StringVal(integration.GcpDatasetId))
+		resource.SetAttributeTraversal("service_account_creds_base64", hcl.Traversal{hcl.TraverseRoot{Name: "var"}, hcl.TraverseAttr{Name: name + "_service_account_creds_base64"}})
+	}
+
+	return file
+}
+
+func (e *exporter) variablesFile() *hclwrite.File {
+	file := hclwrite.NewEmptyFile()
+
+	var variables []string
+	for _, integration := range e.bitfinex {
+		name := e.bitfinexNames[integration.IntegrationId]
+		variables = append(variables, name+"_key", name+"_secret")
+	}
+	for _, integration := range e.bigQuery {
+		variables = append(variables, e.bigQueryNames[integration.IntegrationId]+"_service_account_creds_base64")
+	}
+
+	for i, name := range variables {
+		if i > 0 {
+			file.Body().AppendNewline()
+		}
+		variable := file.Body().AppendNewBlock("variable", []string{name}).Body()
+		variable.SetAttributeTraversal("type", hcl.Traversal{hcl.TraverseRoot{Name: "string"}})
+		variable.SetAttributeValue("sensitive", cty.True)
+	}
+
+	return file
+}
@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclparse"
+)
+
+func TestResourceName(t *testing.T) {
+	cases := map[string]string{
+		"CASH":               "cash",
+		"Customer Deposits":  "customer_deposits",
+		"assets.current-usd": "assets_current_usd",
+		"--BTC--":            "btc",
+		"1000 Cash":          "_1000_cash",
+		"":                   "unnamed",
+		"€€€":                "unnamed",
+	}
+
+	for label, expected := range cases {
+		if name := resourceName(label); name != expected {
+			t.Errorf("%q: expected %s, got %s", label, expected, name)
+		}
+	}
+}
+
+func TestUniqueResourceNames(t *testing.T) {
+	names := uniqueResourceNames(map[string]string{
+		"c": "Cash",
+		"a": "cash",
+		"b": "CASH",
+		"d": "Fees",
+	})
+
+	// Duplicates are numbered in the order of their labels, then their IDs.
+	expected := map[string]string{"b": "cash", "c": "cash_2", "a": "cash_3", "d": "fees"}
+
+	for id, name := range expected {
+		if names[id] != name {
+			t.Errorf("%s: expected %s, got %s", id, name, names[id])
+		}
+	}
+}
+
+const (
+	exportJournalId    = "9a4bd5ba-0f3c-4d7a-8d2b-0bd4e0c3c1f0"
+	exportAccountSetId = "5b0e3f44-2f8f-4f53-9a44-0c6f1b1f6f10"
+	exportCashId       = "00000000-0000-0000-0000-000000000001"
+	exportFeesId       = "00000000-0000-0000-0000-000000000002"
+)
+
+func TestExportLedger(t *testing.T) {
+	client := &fakeClient{responses: map[string]string{
+		"accountsList": `{"accounts": {"pageInfo": {"hasNextPage": false}, "nodes": [
+			{"accountId": "` + exportCashId + `", "code": "CASH", "name": "Cash", "normalBalanceType": "DEBIT", "status": "ACTIVE"},
+			{"accountId": "` + exportFeesId + `", "code": "FEES", "name": "Fees", "normalBalanceType": "CREDIT", "status": "LOCKED", "description": "Collected fees"}
+		]}}`,
+		"accountSetsOfAccount": `{"account": {"sets": {"pageInfo": {"hasNextPage": false}, "nodes": [{"accountSetId": "` + exportAccountSetId + `"}]}}}`,
+		"accountSetGet": `{"accountSet": {"accountSetId": "` + exportAccountSetId + `", "journalId": "` + exportJournalId + `", ` +
+			`"name": "Assets", "normalBalanceType": "DEBIT", "sets": {"nodes": []}}}`,
+		"accountSetsOfAccountSet": `{"accountSet": {"sets": {"pageInfo": {"hasNextPage": false}, "nodes": []}}}`,
+		"accountSetMembersList": `{"accountSet": {"members": {"pageInfo": {"hasNextPage": false}, "nodes": [
+			{"__typename": "Account", "accountId": "` + exportCashId + `", "code": "CASH", "name": "Cash"},
+			{"__typename": "Account", "accountId": "` + exportFeesId + `", "code": "FEES", "name": "Fees"}
+		]}}}`,
+		"journalGet": `{"journal": {"journalId": "` + exportJournalId + `", "name": "General Ledger", "status": "ACTIVE"}}`,
+	}}
+
+	out := t.TempDir()
+
+	if err := exportLedger(context.Background(), client, ExportConfig{OutputDir: out}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string][]string{
+		"journals.tf": {
+			`resource "cala_journal" "general_ledger" {`,
+			`name = "General Ledger"`,
+			`to = cala_journal.general_ledger`,
+			`id = "` + exportJournalId + `"`,
+		},
+		"accounts.tf": {
+			`resource "cala_account" "cash" {`,
+			`resource "cala_account" "fees" {`,
+			`description         = "Collected fees"`,
+			`status              = "LOCKED"`,
+			`to = cala_account.fees`,
+		},
+		"account_sets.tf": {
+			`resource "cala_account_set" "assets" {`,
+			`journal_id          = cala_journal.general_ledger.id`,
+		},
+		"account_set_members.tf": {
+			`resource "cala_account_set_member_account" "assets_cash" {`,
+			`member_account_id = cala_account.cash.id`,
+			`id = "account_set/` + exportAccountSetId + `/account/` + exportFeesId + `"`,
+		},
+	}
+
+	parser := hclparse.NewParser()
+
+	for name, lines := range expected {
+		contents, err := os.ReadFile(filepath.Join(out, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, diags := parser.ParseHCL(contents, name); diags.HasErrors() {
+			t.Errorf("%s is not valid HCL: %s", name, diags)
+		}
+
+		for _, line := range lines {
+			if !strings.Contains(string(contents), line) {
+				t.Errorf("%s does not contain %s:\n%s", name, line, contents)
+			}
+		}
+	}
+
+	if strings.Contains(readFile(t, filepath.Join(out, "accounts.tf")), `status              = "ACTIVE"`) {
+		t.Error("expected the default ACTIVE status to be omitted")
+	}
+
+	// Without integrations there is nothing to write to these files.
+	for _, name := range []string{"integrations.tf", "variables.tf"} {
+		if _, err := os.Stat(filepath.Join(out, name)); !os.IsNotExist(err) {
+			t.Errorf("expected %s not to be written, got %v", name, err)
+		}
+	}
+
+	// A second export refuses to overwrite the files.
+	if err := exportLedger(context.Background(), client, ExportConfig{OutputDir: out}); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("expected the existing files to be kept, got %v", err)
+	}
+}
+
+func readFile(t *testing.T, name string) string {
+	t.Helper()
+
+	contents, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(contents)
+}
+
+func TestExportTransport(t *testing.T) {
+	var header string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("X-Tenant")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {"accounts": {"pageInfo": {"hasNextPage": false}, "nodes": []}}}`))
+	}))
+	defer server.Close()
+
+	config := ExportConfig{
+		Endpoint:  server.URL + "/graphql",
+		Headers:   map[string]string{"X-Tenant": "acme"},
+		OutputDir: t.TempDir(),
+	}
+
+	if err := Export(context.Background(), config); err == nil {
+		t.Fatal("expected the server certificate to be rejected without its CA")
+	}
+
+	config.CaCertFile = filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(config.CaCertFile, []byte(serverCaPem(server)), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Export(context.Background(), config); err != nil {
+		t.Fatalf("ca_cert_file: %s", err)
+	}
+
+	if header != "acme" {
+		t.Errorf("expected the configured headers to be sent, got %q", header)
+	}
+
+	config.ClientCertFile = filepath.Join(t.TempDir(), "missing.pem")
+	if err := Export(context.Background(), config); err == nil || !strings.Contains(err.Error(), "client certificate") {
+		t.Errorf("expected an error for a missing client certificate file, got %v", err)
+	}
+}
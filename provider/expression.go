@@ -0,0 +1,568 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// This file implements a parser for the CEL subset cala accepts in Expression
+// scalars, e.g. `params.amount`, `'SETTLED'` or `decimal('1.5') * params.rate`.
+// It allows tx templates to be checked before they are sent to the server.
+
+// exprNode is a node of a parsed expression.
+type exprNode interface {
+	// offset is the position of the node in the source expression.
+	offset() int
+}
+
+type exprLiteral struct {
+	pos   int
+	kind  exprType
+	value string
+}
+
+type exprIdent struct {
+	pos  int
+	name string
+}
+
+type exprSelect struct {
+	pos     int
+	operand exprNode
+	field   string
+}
+
+type exprIndex struct {
+	pos     int
+	operand exprNode
+	index   exprNode
+}
+
+type exprCall struct {
+	pos      int
+	target   exprNode
+	function string
+	args     []exprNode
+}
+
+type exprUnary struct {
+	pos     int
+	op      string
+	operand exprNode
+}
+
+type exprBinary struct {
+	pos   int
+	op    string
+	left  exprNode
+	right exprNode
+}
+
+type exprConditional struct {
+	pos       int
+	condition exprNode
+	then      exprNode
+	otherwise exprNode
+}
+
+type exprList struct {
+	pos      int
+	elements []exprNode
+}
+
+type exprMap struct {
+	pos    int
+	keys   []exprNode
+	values []exprNode
+}
+
+func (n *exprLiteral) offset() int     { return n.pos }
+func (n *exprIdent) offset() int       { return n.pos }
+func (n *exprSelect) offset() int      { return n.pos }
+func (n *exprIndex) offset() int       { return n.pos }
+func (n *exprCall) offset() int        { return n.pos }
+func (n *exprUnary) offset() int       { return n.pos }
+func (n *exprBinary) offset() int      { return n.pos }
+func (n *exprConditional) offset() int { return n.pos }
+func (n *exprList) offset() int        { return n.pos }
+func (n *exprMap) offset() int         { return n.pos }
+
+// exprError is returned for malformed or ill-typed expressions.
+type exprError struct {
+	pos     int
+	message string
+}
+
+func (e *exprError) Error() string {
+	return fmt.Sprintf("%s (at position %d)", e.message, e.pos+1)
+}
+
+func newExprError(pos int, format string, args ...interface{}) *exprError {
+	return &exprError{pos: pos, message: fmt.Sprintf(format, args...)}
+}
+
+type exprTokenKind int
+
+const (
+	exprTokenEOF exprTokenKind = iota
+	exprTokenIdent
+	exprTokenInt
+	exprTokenDecimal
+	exprTokenString
+	exprTokenOperator
+)
+
+type exprToken struct {
+	kind  exprTokenKind
+	pos   int
+	value string
+}
+
+// exprOperators lists the operators and punctuation, longest first.
+var exprOperators = []string{
+	"&&", "||", "==", "!=", "<=", ">=",
+	"+", "-", "*", "/", "%", "<", ">", "!", "?", ":", ".", ",", "(", ")", "[", "]", "{", "}",
+}
+
+// tokenizeExpression splits an expression into tokens.
+func tokenizeExpression(source string) ([]exprToken, error) {
+	var tokens []exprToken
+
+	i := 0
+	for i < len(source) {
+		c := rune(source[i])
+
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '_' || unicode.IsLetter(c):
+			start := i
+			for i < len(source) && (source[i] == '_' || unicode.IsLetter(rune(source[i])) || unicode.IsDigit(rune(source[i]))) {
+				i++
+			}
+			// Raw string literals, e.g. r'\d+'.
+			if i-start == 1 && (source[start] == 'r' || source[start] == 'R') && i < len(source) && (source[i] == '\'' || source[i] == '"') {
+				value, end, err := scanString(source, i, true)
+				if err != nil {
+					return nil, err
+				}
+				tokens = append(tokens, exprToken{kind: exprTokenString, pos: start, value: value})
+				i = end
+				continue
+			}
+			tokens = append(tokens, exprToken{kind: exprTokenIdent, pos: start, value: source[start:i]})
+		case unicode.IsDigit(c):
+			start := i
+			kind := exprTokenInt
+			if strings.HasPrefix(source[i:], "0x") || strings.HasPrefix(source[i:], "0X") {
+				i += 2
+				for i < len(source) && strings.ContainsRune("0123456789abcdefABCDEF", rune(source[i])) {
+					i++
+				}
+			} else {
+				for i < len(source) && unicode.IsDigit(rune(source[i])) {
+					i++
+				}
+				if i+1 < len(source) && source[i] == '.' && unicode.IsDigit(rune(source[i+1])) {
+					kind = exprTokenDecimal
+					i++
+					for i < len(source) && unicode.IsDigit(rune(source[i])) {
+						i++
+					}
+				}
+				if i < len(source) && (source[i] == 'e' || source[i] == 'E') {
+					kind = exprTokenDecimal
+					i++
+					if i < len(source) && (source[i] == '+' || source[i] == '-') {
+						i++
+					}
+					digits := i
+					for i < len(source) && unicode.IsDigit(rune(source[i])) {
+						i++
+					}
+					if digits == i {
+						return nil, newExprError(start, "malformed number %q", source[start:i])
+					}
+				}
+			}
+			value := source[start:i]
+			// Unsigned integer suffix.
+			if kind == exprTokenInt && i < len(source) && (source[i] == 'u' || source[i] == 'U') {
+				i++
+			}
+			tokens = append(tokens, exprToken{kind: kind, pos: start, value: value})
+		case c == '\'' || c == '"':
+			value, end, err := scanString(source, i, false)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, exprToken{kind: exprTokenString, pos: i, value: value})
+			i = end
+		default:
+			matched := false
+			for _, op := range exprOperators {
+				if strings.HasPrefix(source[i:], op) {
+					tokens = append(tokens, exprToken{kind: exprTokenOperator, pos: i, value: op})
+					i += len(op)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, newExprError(i, "unexpected character %q", c)
+			}
+		}
+	}
+
+	tokens = append(tokens, exprToken{kind: exprTokenEOF, pos: len(source)})
+
+	return tokens, nil
+}
+
+// scanString reads the quoted string starting at source[start] and returns its
+// value and the position after the closing quote.
+func scanString(source string, start int, raw bool) (string, int, error) {
+	quote := source[start]
+	var value strings.Builder
+
+	i := start + 1
+	for i < len(source) {
+		c := source[i]
+
+		switch {
+		case c == quote:
+			return value.String(), i + 1, nil
+		case c == '\\' && !raw:
+			if i+1 >= len(source) {
+				return "", 0, newExprError(i, "unterminated escape sequence")
+			}
+			switch source[i+1] {
+			case 'n':
+				value.WriteByte('\n')
+			case 't':
+				value.WriteByte('\t')
+			case 'r':
+				value.WriteByte('\r')
+			case '\\', '\'', '"', '`', '?':
+				value.WriteByte(source[i+1])
+			default:
+				return "", 0, newExprError(i, "unsupported escape sequence \\%c", source[i+1])
+			}
+			i += 2
+		case c == '\n':
+			return "", 0, newExprError(start, "unterminated string literal")
+		default:
+			value.WriteByte(c)
+			i++
+		}
+	}
+
+	return "", 0, newExprError(start, "unterminated string literal")
+}
+
+type exprParser struct {
+	tokens []exprToken
+	pos    int
+}
+
+// parseExpression parses a cala Expression into its syntax tree.
+func parseExpression(source string) (exprNode, error) {
+	tokens, err := tokenizeExpression(source)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &exprParser{tokens: tokens}
+
+	if p.peek().kind == exprTokenEOF {
+		return nil, newExprError(0, "expression is empty")
+	}
+
+	node, err := p.parseConditional()
+	if err != nil {
+		return nil, err
+	}
+
+	if next := p.peek(); next.kind != exprTokenEOF {
+		return nil, newExprError(next.pos, "unexpected %q", next.value)
+	}
+
+	return node, nil
+}
+
+func (p *exprParser) peek() exprToken {
+	return p.tokens[p.pos]
+}
+
+func (p *exprParser) next() exprToken {
+	token := p.tokens[p.pos]
+	if token.kind != exprTokenEOF {
+		p.pos++
+	}
+	return token
+}
+
+func (p *exprParser) isOperator(values ...string) bool {
+	token := p.peek()
+	if token.kind != exprTokenOperator {
+		return false
+	}
+	for _, value := range values {
+		if token.value == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *exprParser) expect(value string) (exprToken, error) {
+	if !p.isOperator(value) {
+		token := p.peek()
+		if token.kind == exprTokenEOF {
+			return token, newExprError(token.pos, "expected %q but the expression ended", value)
+		}
+		return token, newExprError(token.pos, "expected %q but found %q", value, token.value)
+	}
+	return p.next(), nil
+}
+
+func (p *exprParser) parseConditional() (exprNode, error) {
+	condition, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.isOperator("?") {
+		return condition, nil
+	}
+	question := p.next()
+
+	then, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := p.expect(":"); err != nil {
+		return nil, err
+	}
+
+	otherwise, err := p.parseConditional()
+	if err != nil {
+		return nil, err
+	}
+
+	return &exprConditional{pos: question.pos, condition: condition, then: then, otherwise: otherwise}, nil
+}
+
+func (p *exprParser) parseBinary(operand func() (exprNode, error), operators ...string) (exprNode, error) {
+	left, err := operand()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isOperator(operators...) {
+		op := p.next()
+		right, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		left = &exprBinary{pos: op.pos, op: op.value, left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *exprParser) parseOr() (exprNode, error) {
+	return p.parseBinary(p.parseAnd, "||")
+}
+
+func (p *exprParser) parseAnd() (exprNode, error) {
+	return p.parseBinary(p.parseRelation, "&&")
+}
+
+func (p *exprParser) parseRelation() (exprNode, error) {
+	return p.parseBinary(p.parseAddition, "==", "!=", "<", "<=", ">", ">=")
+}
+
+func (p *exprParser) parseAddition() (exprNode, error) {
+	return p.parseBinary(p.parseMultiplication, "+", "-")
+}
+
+func (p *exprParser) parseMultiplication() (exprNode, error) {
+	return p.parseBinary(p.parseUnary, "*", "/", "%")
+}
+
+func (p *exprParser) parseUnary() (exprNode, error) {
+	if p.isOperator("!", "-") {
+		op := p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &exprUnary{pos: op.pos, op: op.value, operand: operand}, nil
+	}
+
+	return p.parseMember()
+}
+
+func (p *exprParser) parseMember() (exprNode, error) {
+	node, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		switch {
+		case p.isOperator("."):
+			dot := p.next()
+			field := p.next()
+			if field.kind != exprTokenIdent {
+				return nil, newExprError(field.pos, "expected a field name after '.'")
+			}
+			if p.isOperator("(") {
+				args, err := p.parseArguments()
+				if err != nil {
+					return nil, err
+				}
+				node = &exprCall{pos: dot.pos, target: node, function: field.value, args: args}
+			} else {
+				node = &exprSelect{pos: dot.pos, operand: node, field: field.value}
+			}
+		case p.isOperator("["):
+			bracket := p.next()
+			index, err := p.parseConditional()
+			if err != nil {
+				return nil, err
+			}
+			if _, err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			node = &exprIndex{pos: bracket.pos, operand: node, index: index}
+		default:
+			return node, nil
+		}
+	}
+}
+
+func (p *exprParser) parseArguments() ([]exprNode, error) {
+	if _, err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	var args []exprNode
+	for !p.isOperator(")") {
+		arg, err := p.parseConditional()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		if !p.isOperator(",") {
+			break
+		}
+		p.next()
+	}
+
+	if _, err := p.expect(")"); err != nil {
+		return nil, err
+	}
+
+	return args, nil
+}
+
+func (p *exprParser) parsePrimary() (exprNode, error) {
+	token := p.peek()
+
+	switch token.kind {
+	case exprTokenEOF:
+		return nil, newExprError(token.pos, "unexpected end of expression")
+	case exprTokenInt:
+		p.next()
+		if _, err := strconv.ParseInt(token.value, 0, 64); err != nil {
+			return nil, newExprError(token.pos, "invalid integer literal %q", token.value)
+		}
+		return &exprLiteral{pos: token.pos, kind: exprTypeInt, value: token.value}, nil
+	case exprTokenDecimal:
+		p.next()
+		return &exprLiteral{pos: token.pos, kind: exprTypeDecimal, value: token.value}, nil
+	case exprTokenString:
+		p.next()
+		return &exprLiteral{pos: token.pos, kind: exprTypeString, value: token.value}, nil
+	case exprTokenIdent:
+		p.next()
+		switch token.value {
+		case "true", "false":
+			return &exprLiteral{pos: token.pos, kind: exprTypeBool, value: token.value}, nil
+		case "null":
+			return &exprLiteral{pos: token.pos, kind: exprTypeNull}, nil
+		}
+		if p.isOperator("(") {
+			args, err := p.parseArguments()
+			if err != nil {
+				return nil, err
+			}
+			return &exprCall{pos: token.pos, function: token.value, args: args}, nil
+		}
+		return &exprIdent{pos: token.pos, name: token.value}, nil
+	}
+
+	switch token.value {
+	case "(":
+		p.next()
+		node, err := p.parseConditional()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return node, nil
+	case "[":
+		p.next()
+		list := &exprList{pos: token.pos}
+		for !p.isOperator("]") {
+			element, err := p.parseConditional()
+			if err != nil {
+				return nil, err
+			}
+			list.elements = append(list.elements, element)
+			if !p.isOperator(",") {
+				break
+			}
+			p.next()
+		}
+		if _, err := p.expect("]"); err != nil {
+			return nil, err
+		}
+		return list, nil
+	case "{":
+		p.next()
+		m := &exprMap{pos: token.pos}
+		for !p.isOperator("}") {
+			key, err := p.parseConditional()
+			if err != nil {
+				return nil, err
+			}
+			if _, err := p.expect(":"); err != nil {
+				return nil, err
+			}
+			value, err := p.parseConditional()
+			if err != nil {
+				return nil, err
+			}
+			m.keys = append(m.keys, key)
+			m.values = append(m.values, value)
+			if !p.isOperator(",") {
+				break
+			}
+			p.next()
+		}
+		if _, err := p.expect("}"); err != nil {
+			return nil, err
+		}
+		return m, nil
+	}
+
+	return nil, newExprError(token.pos, "unexpected %q", token.value)
+}
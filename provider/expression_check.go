@@ -0,0 +1,516 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// exprType is the static type of an expression.
+type exprType string
+
+const (
+	exprTypeString    exprType = "string"
+	exprTypeInt       exprType = "int"
+	exprTypeDecimal   exprType = "decimal"
+	exprTypeBool      exprType = "bool"
+	exprTypeUuid      exprType = "uuid"
+	exprTypeDate      exprType = "date"
+	exprTypeTimestamp exprType = "timestamp"
+	exprTypeNull      exprType = "null"
+	exprTypeList      exprType = "list"
+	exprTypeMap       exprType = "map"
+	// exprTypeDyn is used for values whose type is only known at evaluation
+	// time, such as JSON params.
+	exprTypeDyn exprType = "dyn"
+	// exprTypeParams is the type of the `params` identifier.
+	exprTypeParams exprType = "params"
+)
+
+// exprConstants are the identifiers cala adds to every evaluation context.
+var exprConstants = map[string]string{
+	"SETTLED":     "SETTLED",
+	"PENDING":     "PENDING",
+	"ENCUMBRANCE": "ENCUMBRANCE",
+	"DEBIT":       "DEBIT",
+	"CREDIT":      "CREDIT",
+}
+
+var decimalLiteralRegexp = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+// paramExprType returns the expression type of a param declared with the
+// given ParamDataType.
+func paramExprType(dataType ParamDataType) (exprType, error) {
+	switch dataType {
+	case ParamDataTypeString:
+		return exprTypeString, nil
+	case ParamDataTypeInteger:
+		return exprTypeInt, nil
+	case ParamDataTypeDecimal:
+		return exprTypeDecimal, nil
+	case ParamDataTypeBoolean:
+		return exprTypeBool, nil
+	case ParamDataTypeUuid:
+		return exprTypeUuid, nil
+	case ParamDataTypeDate:
+		return exprTypeDate, nil
+	case ParamDataTypeTimestamp:
+		return exprTypeTimestamp, nil
+	case ParamDataTypeJson:
+		return exprTypeDyn, nil
+	default:
+		return "", fmt.Errorf("invalid value for ParamDataType: %s", dataType)
+	}
+}
+
+// paramExpectation returns what the default expression of a param declared
+// with the given ParamDataType has to evaluate to. String literals are
+// accepted where the server converts them.
+func paramExpectation(dataType ParamDataType) (exprExpectation, error) {
+	switch dataType {
+	case ParamDataTypeUuid:
+		return exprExpectation{types: []exprType{exprTypeUuid, exprTypeString}, literal: func(value string) error {
+			_, err := uuid.Parse(value)
+			return err
+		}}, nil
+	case ParamDataTypeDate:
+		return exprExpectation{types: []exprType{exprTypeDate, exprTypeString}, literal: func(value string) error {
+			_, err := time.Parse(time.DateOnly, value)
+			return err
+		}}, nil
+	case ParamDataTypeTimestamp:
+		return exprExpectation{types: []exprType{exprTypeTimestamp, exprTypeString}, literal: func(value string) error {
+			_, err := time.Parse(time.RFC3339, value)
+			return err
+		}}, nil
+	case ParamDataTypeJson:
+		return exprExpectation{types: []exprType{
+			exprTypeString, exprTypeInt, exprTypeDecimal, exprTypeBool, exprTypeNull, exprTypeList, exprTypeMap,
+		}}, nil
+	}
+
+	t, err := paramExprType(dataType)
+	if err != nil {
+		return exprExpectation{}, err
+	}
+
+	return exprExpectation{types: []exprType{t}}, nil
+}
+
+func isNumeric(t exprType) bool {
+	return t == exprTypeInt || t == exprTypeDecimal
+}
+
+// exprExpectation describes what an expression has to evaluate to.
+type exprExpectation struct {
+	// types the expression may evaluate to. Expressions of type dyn are
+	// accepted for any expectation.
+	types []exprType
+	// values restricts string literals and constants to a set of values.
+	values []string
+	// literal, when set, validates string literals and constants.
+	literal func(string) error
+}
+
+func (e exprExpectation) String() string {
+	names := make([]string, len(e.types))
+	for i, t := range e.types {
+		names[i] = string(t)
+	}
+	return strings.Join(names, " or ")
+}
+
+// exprChecker type checks parsed expressions against the params declared by
+// a tx template.
+type exprChecker struct {
+	params map[string]exprType
+
+	// warnings collects the calls the checker does not know about. The cala
+	// server may well support them, so they are reported but not rejected.
+	warnings []*exprError
+}
+
+// checkExpression parses source and checks that it evaluates to a value
+// matching expected. Calls to functions or methods unknown to the checker
+// are returned as warnings, their result is not checked.
+func (c *exprChecker) checkExpression(source string, expected exprExpectation) ([]*exprError, error) {
+	c.warnings = nil
+
+	node, err := parseExpression(source)
+	if err != nil {
+		return nil, err
+	}
+
+	actual, err := c.check(node)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.warnings, c.checkValue(node, actual, expected)
+}
+
+// checkValue checks that node, of type actual, matches expected.
+func (c *exprChecker) checkValue(node exprNode, actual exprType, expected exprExpectation) error {
+	if actual == exprTypeDyn {
+		return nil
+	}
+
+	matches := false
+	for _, t := range expected.types {
+		if actual == t || (t == exprTypeDecimal && actual == exprTypeInt) {
+			matches = true
+			break
+		}
+	}
+	if !matches {
+		return newExprError(node.offset(), "expression evaluates to %s but %s is expected", actual, expected)
+	}
+
+	value, ok := constantString(node)
+	if !ok {
+		return nil
+	}
+
+	if len(expected.values) > 0 {
+		allowed := false
+		for _, v := range expected.values {
+			if value == v {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return newExprError(node.offset(), "%q is not one of %s", value, strings.Join(expected.values, ", "))
+		}
+	}
+
+	if expected.literal != nil {
+		if err := expected.literal(value); err != nil {
+			return newExprError(node.offset(), "%q is not valid: %s", value, err)
+		}
+	}
+
+	return nil
+}
+
+// constantString returns the value of a string literal or constant.
+func constantString(node exprNode) (string, bool) {
+	switch n := node.(type) {
+	case *exprLiteral:
+		if n.kind == exprTypeString {
+			return n.value, true
+		}
+	case *exprIdent:
+		value, ok := exprConstants[n.name]
+		return value, ok
+	}
+	return "", false
+}
+
+func (c *exprChecker) check(node exprNode) (exprType, error) {
+	switch n := node.(type) {
+	case *exprLiteral:
+		return n.kind, nil
+
+	case *exprIdent:
+		if n.name == "params" {
+			return exprTypeParams, nil
+		}
+		if _, ok := exprConstants[n.name]; ok {
+			return exprTypeString, nil
+		}
+		if _, ok := c.params[n.name]; ok {
+			return "", newExprError(n.pos, "unknown identifier %q, params are referenced as params.%s", n.name, n.name)
+		}
+		return "", newExprError(n.pos, "unknown identifier %q", n.name)
+
+	case *exprSelect:
+		operand, err := c.check(n.operand)
+		if err != nil {
+			return "", err
+		}
+		return c.checkField(n.pos, operand, n.field)
+
+	case *exprIndex:
+		operand, err := c.check(n.operand)
+		if err != nil {
+			return "", err
+		}
+		index, err := c.check(n.index)
+		if err != nil {
+			return "", err
+		}
+		switch operand {
+		case exprTypeParams:
+			name, ok := constantString(n.index)
+			if !ok {
+				return exprTypeDyn, nil
+			}
+			return c.checkField(n.pos, operand, name)
+		case exprTypeList:
+			if index != exprTypeInt && index != exprTypeDyn {
+				return "", newExprError(n.index.offset(), "list index must be an int, got %s", index)
+			}
+			return exprTypeDyn, nil
+		case exprTypeMap, exprTypeDyn:
+			return exprTypeDyn, nil
+		default:
+			return "", newExprError(n.pos, "cannot index a value of type %s", operand)
+		}
+
+	case *exprCall:
+		return c.checkCall(n)
+
+	case *exprUnary:
+		operand, err := c.check(n.operand)
+		if err != nil {
+			return "", err
+		}
+		switch {
+		case operand == exprTypeDyn:
+			return exprTypeDyn, nil
+		case n.op == "!" && operand == exprTypeBool:
+			return exprTypeBool, nil
+		case n.op == "-" && isNumeric(operand):
+			return operand, nil
+		}
+		return "", newExprError(n.pos, "operator %s cannot be applied to %s", n.op, operand)
+
+	case *exprBinary:
+		return c.checkBinary(n)
+
+	case *exprConditional:
+		condition, err := c.check(n.condition)
+		if err != nil {
+			return "", err
+		}
+		if condition != exprTypeBool && condition != exprTypeDyn {
+			return "", newExprError(n.condition.offset(), "condition must be a bool, got %s", condition)
+		}
+		then, err := c.check(n.then)
+		if err != nil {
+			return "", err
+		}
+		otherwise, err := c.check(n.otherwise)
+		if err != nil {
+			return "", err
+		}
+		switch {
+		case then == otherwise:
+			return then, nil
+		case then == exprTypeDyn || then == exprTypeNull:
+			return otherwise, nil
+		case otherwise == exprTypeDyn || otherwise == exprTypeNull:
+			return then, nil
+		case isNumeric(then) && isNumeric(otherwise):
+			return exprTypeDecimal, nil
+		}
+		return "", newExprError(n.pos, "branches of the conditional have different types: %s and %s", then, otherwise)
+
+	case *exprList:
+		for _, element := range n.elements {
+			if _, err := c.check(element); err != nil {
+				return "", err
+			}
+		}
+		return exprTypeList, nil
+
+	case *exprMap:
+		for i := range n.keys {
+			if _, err := c.check(n.keys[i]); err != nil {
+				return "", err
+			}
+			if _, err := c.check(n.values[i]); err != nil {
+				return "", err
+			}
+		}
+		return exprTypeMap, nil
+	}
+
+	return "", newExprError(node.offset(), "unsupported expression")
+}
+
+func (c *exprChecker) checkField(pos int, operand exprType, field string) (exprType, error) {
+	switch operand {
+	case exprTypeParams:
+		t, ok := c.params[field]
+		if !ok {
+			return "", newExprError(pos, "params.%s is not declared by the tx template", field)
+		}
+		return t, nil
+	case exprTypeMap, exprTypeDyn:
+		return exprTypeDyn, nil
+	}
+	return "", newExprError(pos, "a value of type %s has no field %q", operand, field)
+}
+
+// checkCall checks calls to the functions cala makes available.
+func (c *exprChecker) checkCall(n *exprCall) (exprType, error) {
+	if n.target != nil {
+		for _, operand := range append([]exprNode{n.target}, n.args...) {
+			if _, err := c.check(operand); err != nil {
+				return "", err
+			}
+		}
+		return c.unknownCall(n, "method")
+	}
+
+	// has() is a macro testing for the presence of a field, its argument is
+	// not evaluated.
+	if n.function == "has" {
+		if len(n.args) != 1 {
+			return "", newExprError(n.pos, "has() takes exactly one argument")
+		}
+		if _, ok := n.args[0].(*exprSelect); !ok {
+			return "", newExprError(n.args[0].offset(), "has() requires a field selection such as params.name")
+		}
+		return exprTypeBool, nil
+	}
+
+	args := make([]exprType, len(n.args))
+	for i, arg := range n.args {
+		t, err := c.check(arg)
+		if err != nil {
+			return "", err
+		}
+		args[i] = t
+	}
+
+	// conversion checks the single argument of a conversion function and, for
+	// string literals, that the value can be converted.
+	conversion := func(result exprType, accepted []exprType, parse func(string) error) (exprType, error) {
+		if len(args) != 1 {
+			return "", newExprError(n.pos, "%s() takes exactly one argument", n.function)
+		}
+		if args[0] != exprTypeDyn {
+			ok := false
+			for _, t := range accepted {
+				if args[0] == t {
+					ok = true
+					break
+				}
+			}
+			if !ok {
+				return "", newExprError(n.args[0].offset(), "%s() cannot convert a value of type %s", n.function, args[0])
+			}
+		}
+		if literal, ok := n.args[0].(*exprLiteral); ok && literal.kind == exprTypeString && parse != nil {
+			if err := parse(literal.value); err != nil {
+				return "", newExprError(literal.pos, "%q is not a valid %s: %s", literal.value, result, err)
+			}
+		}
+		return result, nil
+	}
+
+	switch n.function {
+	case "decimal":
+		return conversion(exprTypeDecimal, []exprType{exprTypeString, exprTypeInt, exprTypeDecimal}, func(value string) error {
+			if !decimalLiteralRegexp.MatchString(value) {
+				return fmt.Errorf("expected digits with an optional fraction")
+			}
+			return nil
+		})
+	case "uuid":
+		return conversion(exprTypeUuid, []exprType{exprTypeString, exprTypeUuid}, func(value string) error {
+			_, err := uuid.Parse(value)
+			return err
+		})
+	case "date":
+		if len(args) == 0 {
+			return exprTypeDate, nil
+		}
+		return conversion(exprTypeDate, []exprType{exprTypeString, exprTypeDate, exprTypeTimestamp}, func(value string) error {
+			_, err := time.Parse(time.DateOnly, value)
+			return err
+		})
+	case "timestamp":
+		if len(args) == 0 {
+			return exprTypeTimestamp, nil
+		}
+		return conversion(exprTypeTimestamp, []exprType{exprTypeString, exprTypeTimestamp}, func(value string) error {
+			_, err := time.Parse(time.RFC3339, value)
+			return err
+		})
+	case "string":
+		if len(args) != 1 {
+			return "", newExprError(n.pos, "string() takes exactly one argument")
+		}
+		return exprTypeString, nil
+	case "int":
+		return conversion(exprTypeInt, []exprType{exprTypeString, exprTypeInt, exprTypeDecimal}, nil)
+	case "size":
+		return conversion(exprTypeInt, []exprType{exprTypeString, exprTypeList, exprTypeMap}, nil)
+	}
+
+	return c.unknownCall(n, "function")
+}
+
+// unknownCall records a warning for a call the checker does not know about,
+// once its arguments have been checked. Its result can be of any type.
+func (c *exprChecker) unknownCall(n *exprCall, kind string) (exprType, error) {
+	c.warnings = append(c.warnings, newExprError(n.pos, "unknown %s %q, it cannot be checked before it is sent to the cala server", kind, n.function))
+	return exprTypeDyn, nil
+}
+
+func (c *exprChecker) checkBinary(n *exprBinary) (exprType, error) {
+	left, err := c.check(n.left)
+	if err != nil {
+		return "", err
+	}
+	right, err := c.check(n.right)
+	if err != nil {
+		return "", err
+	}
+
+	if left == exprTypeParams || right == exprTypeParams {
+		return "", newExprError(n.pos, "params cannot be used as a value, select a param with params.name")
+	}
+
+	dyn := left == exprTypeDyn || right == exprTypeDyn
+	mismatch := newExprError(n.pos, "operator %s cannot be applied to %s and %s", n.op, left, right)
+
+	switch n.op {
+	case "&&", "||":
+		if (left == exprTypeBool || left == exprTypeDyn) && (right == exprTypeBool || right == exprTypeDyn) {
+			return exprTypeBool, nil
+		}
+		return "", mismatch
+
+	case "==", "!=":
+		if dyn || left == right || left == exprTypeNull || right == exprTypeNull || (isNumeric(left) && isNumeric(right)) {
+			return exprTypeBool, nil
+		}
+		return "", mismatch
+
+	case "<", "<=", ">", ">=":
+		if dyn || (isNumeric(left) && isNumeric(right)) {
+			return exprTypeBool, nil
+		}
+		if left == right && (left == exprTypeString || left == exprTypeDate || left == exprTypeTimestamp) {
+			return exprTypeBool, nil
+		}
+		return "", mismatch
+
+	case "+":
+		if left == right && (left == exprTypeString || left == exprTypeList) {
+			return left, nil
+		}
+		fallthrough
+
+	case "-", "*", "/", "%":
+		switch {
+		case dyn:
+			return exprTypeDyn, nil
+		case left == exprTypeInt && right == exprTypeInt:
+			return exprTypeInt, nil
+		case isNumeric(left) && isNumeric(right) && n.op != "%":
+			return exprTypeDecimal, nil
+		}
+		return "", mismatch
+	}
+
+	return "", newExprError(n.pos, "unsupported operator %s", n.op)
+}
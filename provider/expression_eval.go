@@ -0,0 +1,745 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// exprDate and exprTimestamp distinguish dates from timestamps during
+// evaluation, both are backed by a time.Time.
+type exprDate time.Time
+
+type exprTimestamp time.Time
+
+// exprEvaluator evaluates parsed expressions locally. Values are represented
+// as string, int64, decimal.Decimal, bool, uuid.UUID, exprDate,
+// exprTimestamp, nil, []interface{} or map[string]interface{}.
+type exprEvaluator struct {
+	params map[string]interface{}
+	// now is used by date() and timestamp() without arguments.
+	now func() time.Time
+}
+
+// evalExpression parses source and evaluates it.
+func (e *exprEvaluator) evalExpression(source string) (interface{}, error) {
+	node, err := parseExpression(source)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.eval(node)
+}
+
+// paramValue converts a sample param value, as decoded from terraform, into
+// the value of a param declared with the given ParamDataType.
+func paramValue(dataType ParamDataType, value interface{}) (interface{}, error) {
+	invalid := fmt.Errorf("%v is not a valid %s", value, dataType)
+
+	switch dataType {
+	case ParamDataTypeString:
+		if v, ok := value.(string); ok {
+			return v, nil
+		}
+	case ParamDataTypeInteger:
+		switch v := value.(type) {
+		case int64:
+			return v, nil
+		case json.Number, string:
+			i, err := strconv.ParseInt(fmt.Sprint(v), 10, 64)
+			if err != nil {
+				return nil, invalid
+			}
+			return i, nil
+		}
+	case ParamDataTypeDecimal:
+		switch v := value.(type) {
+		case int64:
+			return decimal.NewFromInt(v), nil
+		case json.Number, string:
+			d, err := decimal.NewFromString(fmt.Sprint(v))
+			if err != nil {
+				return nil, invalid
+			}
+			return d, nil
+		}
+	case ParamDataTypeBoolean:
+		if v, ok := value.(bool); ok {
+			return v, nil
+		}
+	case ParamDataTypeUuid:
+		if v, ok := value.(string); ok {
+			id, err := uuid.Parse(v)
+			if err != nil {
+				return nil, invalid
+			}
+			return id, nil
+		}
+	case ParamDataTypeDate:
+		if v, ok := value.(string); ok {
+			t, err := time.Parse(time.DateOnly, v)
+			if err != nil {
+				return nil, invalid
+			}
+			return exprDate(t), nil
+		}
+	case ParamDataTypeTimestamp:
+		if v, ok := value.(string); ok {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return nil, invalid
+			}
+			return exprTimestamp(t), nil
+		}
+	case ParamDataTypeJson:
+		return jsonToExpr(value), nil
+	default:
+		return nil, fmt.Errorf("invalid value for ParamDataType: %s", dataType)
+	}
+
+	return nil, invalid
+}
+
+// jsonToExpr converts a decoded JSON value into an expression value.
+func jsonToExpr(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		if d, err := decimal.NewFromString(v.String()); err == nil {
+			return d
+		}
+		return v.String()
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, element := range v {
+			result[i] = jsonToExpr(element)
+		}
+		return result
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, element := range v {
+			result[key] = jsonToExpr(element)
+		}
+		return result
+	}
+	return value
+}
+
+// exprToJson converts an expression value into a value encoding/json can
+// marshal without losing precision.
+func exprToJson(value interface{}) interface{} {
+	switch v := value.(type) {
+	case decimal.Decimal:
+		return json.Number(v.String())
+	case uuid.UUID, exprDate, exprTimestamp:
+		return formatExprValue(v)
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, element := range v {
+			result[i] = exprToJson(element)
+		}
+		return result
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, element := range v {
+			result[key] = exprToJson(element)
+		}
+		return result
+	}
+	return value
+}
+
+// formatExprValue formats a value the way string() does.
+func formatExprValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return v
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case decimal.Decimal:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	case uuid.UUID:
+		return v.String()
+	case exprDate:
+		return time.Time(v).Format(time.DateOnly)
+	case exprTimestamp:
+		return time.Time(v).Format(time.RFC3339)
+	default:
+		encoded, err := json.Marshal(exprToJson(v))
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(encoded)
+	}
+}
+
+// exprValueType returns the exprType of an evaluated value.
+func exprValueType(value interface{}) exprType {
+	switch value.(type) {
+	case nil:
+		return exprTypeNull
+	case string:
+		return exprTypeString
+	case int64:
+		return exprTypeInt
+	case decimal.Decimal:
+		return exprTypeDecimal
+	case bool:
+		return exprTypeBool
+	case uuid.UUID:
+		return exprTypeUuid
+	case exprDate:
+		return exprTypeDate
+	case exprTimestamp:
+		return exprTypeTimestamp
+	case []interface{}:
+		return exprTypeList
+	case map[string]interface{}:
+		return exprTypeMap
+	}
+	return exprTypeDyn
+}
+
+func toDecimal(value interface{}) (decimal.Decimal, bool) {
+	switch v := value.(type) {
+	case int64:
+		return decimal.NewFromInt(v), true
+	case decimal.Decimal:
+		return v, true
+	}
+	return decimal.Decimal{}, false
+}
+
+func (e *exprEvaluator) eval(node exprNode) (interface{}, error) {
+	switch n := node.(type) {
+	case *exprLiteral:
+		switch n.kind {
+		case exprTypeString:
+			return n.value, nil
+		case exprTypeInt:
+			i, err := strconv.ParseInt(n.value, 0, 64)
+			if err != nil {
+				return nil, newExprError(n.pos, "invalid integer literal %q", n.value)
+			}
+			return i, nil
+		case exprTypeDecimal:
+			d, err := decimal.NewFromString(n.value)
+			if err != nil {
+				return nil, newExprError(n.pos, "invalid decimal literal %q", n.value)
+			}
+			return d, nil
+		case exprTypeBool:
+			return n.value == "true", nil
+		case exprTypeNull:
+			return nil, nil
+		}
+
+	case *exprIdent:
+		if n.name == "params" {
+			return nil, newExprError(n.pos, "params cannot be used as a value, select a param with params.name")
+		}
+		if value, ok := exprConstants[n.name]; ok {
+			return value, nil
+		}
+		return nil, newExprError(n.pos, "unknown identifier %q", n.name)
+
+	case *exprSelect:
+		return e.evalField(n.pos, n.operand, n.field)
+
+	case *exprIndex:
+		if ident, ok := n.operand.(*exprIdent); ok && ident.name == "params" {
+			index, err := e.eval(n.index)
+			if err != nil {
+				return nil, err
+			}
+			name, ok := index.(string)
+			if !ok {
+				return nil, newExprError(n.index.offset(), "params must be indexed by a string, got %s", exprValueType(index))
+			}
+			return e.evalField(n.pos, n.operand, name)
+		}
+		operand, err := e.eval(n.operand)
+		if err != nil {
+			return nil, err
+		}
+		index, err := e.eval(n.index)
+		if err != nil {
+			return nil, err
+		}
+		switch v := operand.(type) {
+		case []interface{}:
+			i, ok := index.(int64)
+			if !ok {
+				return nil, newExprError(n.index.offset(), "list index must be an int, got %s", exprValueType(index))
+			}
+			if i < 0 || i >= int64(len(v)) {
+				return nil, newExprError(n.index.offset(), "index %d out of range", i)
+			}
+			return v[i], nil
+		case map[string]interface{}:
+			key, ok := index.(string)
+			if !ok {
+				return nil, newExprError(n.index.offset(), "map key must be a string, got %s", exprValueType(index))
+			}
+			value, ok := v[key]
+			if !ok {
+				return nil, newExprError(n.pos, "no such key %q", key)
+			}
+			return value, nil
+		}
+		return nil, newExprError(n.pos, "cannot index a value of type %s", exprValueType(operand))
+
+	case *exprCall:
+		return e.evalCall(n)
+
+	case *exprUnary:
+		operand, err := e.eval(n.operand)
+		if err != nil {
+			return nil, err
+		}
+		switch v := operand.(type) {
+		case bool:
+			if n.op == "!" {
+				return !v, nil
+			}
+		case int64:
+			if n.op == "-" {
+				return -v, nil
+			}
+		case decimal.Decimal:
+			if n.op == "-" {
+				return v.Neg(), nil
+			}
+		}
+		return nil, newExprError(n.pos, "operator %s cannot be applied to %s", n.op, exprValueType(operand))
+
+	case *exprBinary:
+		return e.evalBinary(n)
+
+	case *exprConditional:
+		condition, err := e.eval(n.condition)
+		if err != nil {
+			return nil, err
+		}
+		b, ok := condition.(bool)
+		if !ok {
+			return nil, newExprError(n.condition.offset(), "condition must be a bool, got %s", exprValueType(condition))
+		}
+		if b {
+			return e.eval(n.then)
+		}
+		return e.eval(n.otherwise)
+
+	case *exprList:
+		list := make([]interface{}, 0, len(n.elements))
+		for _, element := range n.elements {
+			value, err := e.eval(element)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, value)
+		}
+		return list, nil
+
+	case *exprMap:
+		m := make(map[string]interface{}, len(n.keys))
+		for i := range n.keys {
+			key, err := e.eval(n.keys[i])
+			if err != nil {
+				return nil, err
+			}
+			name, ok := key.(string)
+			if !ok {
+				return nil, newExprError(n.keys[i].offset(), "map key must be a string, got %s", exprValueType(key))
+			}
+			value, err := e.eval(n.values[i])
+			if err != nil {
+				return nil, err
+			}
+			m[name] = value
+		}
+		return m, nil
+	}
+
+	return nil, newExprError(node.offset(), "unsupported expression")
+}
+
+// evalField selects field from the value of operand. Fields of params are
+// looked up in the params of the evaluator.
+func (e *exprEvaluator) evalField(pos int, operand exprNode, field string) (interface{}, error) {
+	if ident, ok := operand.(*exprIdent); ok && ident.name == "params" {
+		value, ok := e.params[field]
+		if !ok {
+			return nil, newExprError(pos, "params.%s has no value", field)
+		}
+		return value, nil
+	}
+
+	value, err := e.eval(operand)
+	if err != nil {
+		return nil, err
+	}
+
+	m, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, newExprError(pos, "a value of type %s has no field %q", exprValueType(value), field)
+	}
+
+	result, ok := m[field]
+	if !ok {
+		return nil, newExprError(pos, "no such key %q", field)
+	}
+
+	return result, nil
+}
+
+func (e *exprEvaluator) evalCall(n *exprCall) (interface{}, error) {
+	if n.target != nil {
+		return nil, newExprError(n.pos, "unknown method %q", n.function)
+	}
+
+	if n.function == "has" {
+		if len(n.args) != 1 {
+			return nil, newExprError(n.pos, "has() takes exactly one argument")
+		}
+		selection, ok := n.args[0].(*exprSelect)
+		if !ok {
+			return nil, newExprError(n.args[0].offset(), "has() requires a field selection such as params.name")
+		}
+		if ident, ok := selection.operand.(*exprIdent); ok && ident.name == "params" {
+			_, ok := e.params[selection.field]
+			return ok, nil
+		}
+		value, err := e.eval(selection.operand)
+		if err != nil {
+			return nil, err
+		}
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, newExprError(selection.pos, "has() cannot be applied to %s", exprValueType(value))
+		}
+		_, ok = m[selection.field]
+		return ok, nil
+	}
+
+	args := make([]interface{}, len(n.args))
+	for i, arg := range n.args {
+		value, err := e.eval(arg)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = value
+	}
+
+	if len(args) == 0 {
+		switch n.function {
+		case "date":
+			year, month, day := e.now().UTC().Date()
+			return exprDate(time.Date(year, month, day, 0, 0, 0, 0, time.UTC)), nil
+		case "timestamp":
+			return exprTimestamp(e.now().UTC()), nil
+		}
+	}
+
+	switch n.function {
+	case "decimal", "uuid", "date", "timestamp", "string", "int", "size":
+	default:
+		return nil, newExprError(n.pos, "unknown function %q", n.function)
+	}
+
+	if len(args) != 1 {
+		return nil, newExprError(n.pos, "%s() takes exactly one argument", n.function)
+	}
+
+	arg := args[0]
+	invalid := newExprError(n.args[0].offset(), "%s() cannot convert %q", n.function, formatExprValue(arg))
+
+	switch n.function {
+	case "decimal":
+		switch v := arg.(type) {
+		case string:
+			if !decimalLiteralRegexp.MatchString(v) {
+				return nil, invalid
+			}
+			return decimal.NewFromString(v)
+		case int64, decimal.Decimal:
+			d, _ := toDecimal(v)
+			return d, nil
+		}
+	case "uuid":
+		switch v := arg.(type) {
+		case string:
+			id, err := uuid.Parse(v)
+			if err != nil {
+				return nil, invalid
+			}
+			return id, nil
+		case uuid.UUID:
+			return v, nil
+		}
+	case "date":
+		switch v := arg.(type) {
+		case string:
+			t, err := time.Parse(time.DateOnly, v)
+			if err != nil {
+				return nil, invalid
+			}
+			return exprDate(t), nil
+		case exprDate:
+			return v, nil
+		case exprTimestamp:
+			year, month, day := time.Time(v).UTC().Date()
+			return exprDate(time.Date(year, month, day, 0, 0, 0, 0, time.UTC)), nil
+		}
+	case "timestamp":
+		switch v := arg.(type) {
+		case string:
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return nil, invalid
+			}
+			return exprTimestamp(t), nil
+		case exprTimestamp:
+			return v, nil
+		}
+	case "string":
+		return formatExprValue(arg), nil
+	case "int":
+		switch v := arg.(type) {
+		case string:
+			i, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return nil, invalid
+			}
+			return i, nil
+		case int64:
+			return v, nil
+		case decimal.Decimal:
+			return v.IntPart(), nil
+		}
+	case "size":
+		switch v := arg.(type) {
+		case string:
+			return int64(utf8.RuneCountInString(v)), nil
+		case []interface{}:
+			return int64(len(v)), nil
+		case map[string]interface{}:
+			return int64(len(v)), nil
+		}
+	default:
+		return nil, newExprError(n.pos, "unknown function %q", n.function)
+	}
+
+	return nil, newExprError(n.args[0].offset(), "%s() cannot convert a value of type %s", n.function, exprValueType(arg))
+}
+
+func (e *exprEvaluator) evalBinary(n *exprBinary) (interface{}, error) {
+	left, err := e.eval(n.left)
+	if err != nil {
+		return nil, err
+	}
+
+	// && and || short-circuit like they do on the server.
+	if n.op == "&&" || n.op == "||" {
+		l, ok := left.(bool)
+		if !ok {
+			return nil, newExprError(n.left.offset(), "operator %s cannot be applied to %s", n.op, exprValueType(left))
+		}
+		if (n.op == "&&" && !l) || (n.op == "||" && l) {
+			return l, nil
+		}
+		right, err := e.eval(n.right)
+		if err != nil {
+			return nil, err
+		}
+		r, ok := right.(bool)
+		if !ok {
+			return nil, newExprError(n.right.offset(), "operator %s cannot be applied to %s", n.op, exprValueType(right))
+		}
+		return r, nil
+	}
+
+	right, err := e.eval(n.right)
+	if err != nil {
+		return nil, err
+	}
+
+	mismatch := newExprError(n.pos, "operator %s cannot be applied to %s and %s", n.op, exprValueType(left), exprValueType(right))
+
+	switch n.op {
+	case "==", "!=":
+		equal := exprEqual(left, right)
+		return equal == (n.op == "=="), nil
+
+	case "<", "<=", ">", ">=":
+		cmp, ok := exprCompare(left, right)
+		if !ok {
+			return nil, mismatch
+		}
+		switch n.op {
+		case "<":
+			return cmp < 0, nil
+		case "<=":
+			return cmp <= 0, nil
+		case ">":
+			return cmp > 0, nil
+		default:
+			return cmp >= 0, nil
+		}
+
+	case "+":
+		switch l := left.(type) {
+		case string:
+			if r, ok := right.(string); ok {
+				return l + r, nil
+			}
+		case []interface{}:
+			if r, ok := right.([]interface{}); ok {
+				return append(append([]interface{}{}, l...), r...), nil
+			}
+		}
+	}
+
+	l, lok := left.(int64)
+	r, rok := right.(int64)
+	if lok && rok {
+		switch n.op {
+		case "+":
+			return l + r, nil
+		case "-":
+			return l - r, nil
+		case "*":
+			return l * r, nil
+		case "/", "%":
+			if r == 0 {
+				return nil, newExprError(n.pos, "division by zero")
+			}
+			if n.op == "/" {
+				return l / r, nil
+			}
+			return l % r, nil
+		}
+	}
+
+	ld, lok := toDecimal(left)
+	rd, rok := toDecimal(right)
+	if !lok || !rok {
+		return nil, mismatch
+	}
+
+	switch n.op {
+	case "+":
+		return ld.Add(rd), nil
+	case "-":
+		return ld.Sub(rd), nil
+	case "*":
+		return ld.Mul(rd), nil
+	case "/":
+		if rd.IsZero() {
+			return nil, newExprError(n.pos, "division by zero")
+		}
+		return ld.Div(rd), nil
+	}
+
+	return nil, mismatch
+}
+
+// exprEqual compares two values, ints and decimals compare by value.
+func exprEqual(left, right interface{}) bool {
+	if l, ok := toDecimal(left); ok {
+		if r, ok := toDecimal(right); ok {
+			return l.Equal(r)
+		}
+		return false
+	}
+
+	switch l := left.(type) {
+	case exprDate:
+		r, ok := right.(exprDate)
+		return ok && time.Time(l).Equal(time.Time(r))
+	case exprTimestamp:
+		r, ok := right.(exprTimestamp)
+		return ok && time.Time(l).Equal(time.Time(r))
+	case []interface{}:
+		r, ok := right.([]interface{})
+		if !ok || len(l) != len(r) {
+			return false
+		}
+		for i := range l {
+			if !exprEqual(l[i], r[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		r, ok := right.(map[string]interface{})
+		if !ok || len(l) != len(r) {
+			return false
+		}
+		for key, value := range l {
+			other, ok := r[key]
+			if !ok || !exprEqual(value, other) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return reflect.DeepEqual(left, right)
+}
+
+// exprCompare orders two numbers, strings, dates or timestamps.
+func exprCompare(left, right interface{}) (int, bool) {
+	if l, ok := toDecimal(left); ok {
+		if r, ok := toDecimal(right); ok {
+			return l.Cmp(r), true
+		}
+		return 0, false
+	}
+
+	switch l := left.(type) {
+	case string:
+		if r, ok := right.(string); ok {
+			switch {
+			case l < r:
+				return -1, true
+			case l > r:
+				return 1, true
+			}
+			return 0, true
+		}
+	case exprDate:
+		if r, ok := right.(exprDate); ok {
+			return time.Time(l).Compare(time.Time(r)), true
+		}
+	case exprTimestamp:
+		if r, ok := right.(exprTimestamp); ok {
+			return time.Time(l).Compare(time.Time(r)), true
+		}
+	}
+
+	return 0, false
+}
+
+// sortedKeys returns the keys of m in a stable order.
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
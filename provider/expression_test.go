@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestParseExpression(t *testing.T) {
+	cases := []struct {
+		source string
+		err    string
+	}{
+		{"params.amount", ""},
+		{"'SETTLED'", ""},
+		{`"double quoted"`, ""},
+		{"SETTLED", ""},
+		{"decimal('1.5') * params.rate", ""},
+		{"-params.amount", ""},
+		{"!params.flag", ""},
+		{"params.a + params.b * 2 - 1", ""},
+		{"(params.a + params.b) / 2", ""},
+		{"params.flag ? 'DEBIT' : 'CREDIT'", ""},
+		{"params.a == 1 && params.b != 2 || params.c < 3", ""},
+		{"params.meta['key']", ""},
+		{"params.meta.nested.field", ""},
+		{"[1, 2, 3]", ""},
+		{"{'a': 1, 'b': params.b}", ""},
+		{"has(params.meta.key)", ""},
+		{"params.name.startsWith('a')", ""},
+		{"null", ""},
+		{"true", ""},
+		{"1.25", ""},
+		{"", "empty"},
+		{"params.", "expected"},
+		{"(params.amount", "expected"},
+		{"'unterminated", "unterminated"},
+		{"params.amount params.rate", "unexpected"},
+		{"1 +", "unexpected end"},
+		{"params.flag ? 'DEBIT'", "expected"},
+		{"[1, 2", "expected"},
+	}
+
+	for _, c := range cases {
+		_, err := parseExpression(c.source)
+
+		if c.err == "" {
+			if err != nil {
+				t.Errorf("%q: unexpected error: %s", c.source, err)
+			}
+			continue
+		}
+
+		if err == nil || !strings.Contains(err.Error(), c.err) {
+			t.Errorf("%q: expected an error containing %q, got %v", c.source, c.err, err)
+		}
+	}
+}
+
+func TestCheckExpression(t *testing.T) {
+	checker := &exprChecker{params: map[string]exprType{
+		"amount":    exprTypeDecimal,
+		"count":     exprTypeInt,
+		"name":      exprTypeString,
+		"flag":      exprTypeBool,
+		"accountId": exprTypeUuid,
+		"effective": exprTypeDate,
+		"meta":      exprTypeDyn,
+	}}
+
+	anyType := exprExpectation{types: []exprType{
+		exprTypeString, exprTypeInt, exprTypeDecimal, exprTypeBool, exprTypeUuid,
+		exprTypeDate, exprTypeTimestamp, exprTypeNull, exprTypeList, exprTypeMap,
+	}}
+	decimalType := exprExpectation{types: []exprType{exprTypeDecimal}}
+	uuidType := exprExpectation{types: []exprType{exprTypeUuid, exprTypeString}, literal: func(value string) error {
+		_, err := uuid.Parse(value)
+		return err
+	}}
+	layer := exprExpectation{types: []exprType{exprTypeString}, values: []string{"SETTLED", "PENDING", "ENCUMBRANCE"}}
+
+	cases := []struct {
+		source   string
+		expected exprExpectation
+		err      string
+		warnings int
+	}{
+		{"params.amount", decimalType, "", 0},
+		{"params.count", decimalType, "", 0},
+		{"params.amount * decimal('1.5')", decimalType, "", 0},
+		{"params.amount + params.count", decimalType, "", 0},
+		{"decimal(params.name)", decimalType, "", 0},
+		{"params.meta.value", decimalType, "", 0},
+		{"params.flag ? params.amount : decimal('0')", decimalType, "", 0},
+		{"params.accountId", uuidType, "", 0},
+		{"'b9a5ea5c-6ba6-4a29-a0e6-8b8ea7a64e5c'", uuidType, "", 0},
+		{"uuid('b9a5ea5c-6ba6-4a29-a0e6-8b8ea7a64e5c')", uuidType, "", 0},
+		{"SETTLED", layer, "", 0},
+		{"'PENDING'", layer, "", 0},
+		{"has(params.meta.key)", anyType, "", 0},
+		{"date()", anyType, "", 0},
+		{"date('2024-02-29')", anyType, "", 0},
+		{"timestamp('2024-02-29T10:00:00Z')", anyType, "", 0},
+		{"size(params.name) > 3", anyType, "", 0},
+		{"string(params.amount)", anyType, "", 0},
+		{"params.effective", anyType, "", 0},
+
+		// Calls the checker does not know about are only warned about.
+		{"params.name.startsWith('a')", anyType, "", 1},
+		{"matches(params.name, '^a')", anyType, "", 1},
+		{"decimal(params.name.trim())", decimalType, "", 1},
+		{"currency(params.amount, unknownFn(1))", decimalType, "", 2},
+
+		{"params.missing", decimalType, "missing", 0},
+		{"params.name", decimalType, "string but decimal is expected", 0},
+		{"params.flag + 1", anyType, "cannot be applied to bool and int", 0},
+		{"'not a uuid'", uuidType, "is not valid", 0},
+		{"uuid('not a uuid')", uuidType, "not a valid uuid", 0},
+		{"decimal('1,5')", decimalType, "not a valid decimal", 0},
+		{"date('2024-02-30')", anyType, "not a valid date", 0},
+		{"'FINAL'", layer, "is not one of", 0},
+		{"has(params)", anyType, "field selection", 0},
+		{"decimal(params.flag)", decimalType, "cannot convert", 0},
+		{"unknownIdent", anyType, "unknownIdent", 0},
+		{"params.name.startsWith(params.missing)", anyType, "missing", 0},
+	}
+
+	for _, c := range cases {
+		warnings, err := checker.checkExpression(c.source, c.expected)
+
+		if c.err == "" {
+			if err != nil {
+				t.Errorf("%q: unexpected error: %s", c.source, err)
+			}
+		} else if err == nil || !strings.Contains(err.Error(), c.err) {
+			t.Errorf("%q: expected an error containing %q, got %v", c.source, c.err, err)
+		}
+
+		if len(warnings) != c.warnings {
+			t.Errorf("%q: expected %d warnings, got %v", c.source, c.warnings, warnings)
+		}
+	}
+}
+
+func TestEvalExpression(t *testing.T) {
+	now := time.Date(2024, 2, 29, 10, 30, 0, 0, time.UTC)
+	evaluator := &exprEvaluator{
+		params: map[string]interface{}{
+			"amount": decimal.RequireFromString("10.50"),
+			"count":  int64(3),
+			"name":   "alice",
+			"flag":   true,
+			"meta":   map[string]interface{}{"key": "value", "list": []interface{}{int64(1), int64(2)}},
+		},
+		now: func() time.Time { return now },
+	}
+
+	cases := []struct {
+		source string
+		result string
+		err    string
+	}{
+		{"params.amount", "10.5", ""},
+		{"params.amount * decimal('2')", "21", ""},
+		{"params.amount + params.count", "13.5", ""},
+		{"params.count * 2 - 1", "5", ""},
+		{"-params.count", "-3", ""},
+		{"params.name", "alice", ""},
+		{"'a' + 'b'", "ab", ""},
+		{"params.flag ? DEBIT : CREDIT", "DEBIT", ""},
+		{"!params.flag", "false", ""},
+		{"params.count > 2 && params.name == 'alice'", "true", ""},
+		{"params.meta.key", "value", ""},
+		{"params.meta['key']", "value", ""},
+		{"params.meta.list[1]", "2", ""},
+		{"has(params.meta.key)", "true", ""},
+		{"has(params.meta.missing)", "false", ""},
+		{"size(params.name)", "5", ""},
+		{"string(params.count)", "3", ""},
+		{"int('42')", "42", ""},
+		{"uuid('B9A5EA5C-6BA6-4A29-A0E6-8B8EA7A64E5C')", "b9a5ea5c-6ba6-4a29-a0e6-8b8ea7a64e5c", ""},
+		{"date('2024-01-31')", "2024-01-31", ""},
+		{"date()", "2024-02-29", ""},
+		{"timestamp()", "2024-02-29T10:30:00Z", ""},
+		{"[1, 'a']", `[1,"a"]`, ""},
+		{"null", "null", ""},
+
+		{"params.missing", "", "missing"},
+		{"params.count / 0", "", "division by zero"},
+		{"params.meta.list[5]", "", "out of range"},
+		{"params.name.startsWith('a')", "", "unknown method"},
+		{"matches(params.name, '^a')", "", "unknown function"},
+	}
+
+	for _, c := range cases {
+		value, err := evaluator.evalExpression(c.source)
+
+		if c.err != "" {
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Errorf("%q: expected an error containing %q, got %v", c.source, c.err, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", c.source, err)
+			continue
+		}
+
+		if result := formatExprValue(value); result != c.result {
+			t.Errorf("%q: expected %s, got %s", c.source, c.result, result)
+		}
+	}
+}
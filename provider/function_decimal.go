@@ -0,0 +1,290 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/shopspring/decimal"
+)
+
+// The decimal functions take and return decimals as strings, HCL numbers are
+// converted to float64 by some operations and would lose precision.
+
+var (
+	_ function.Function = &DecimalAddFunction{}
+	_ function.Function = &DecimalSubFunction{}
+	_ function.Function = &DecimalCmpFunction{}
+	_ function.Function = &DecimalRoundFunction{}
+	_ function.Function = &MoneyFormatFunction{}
+)
+
+func NewDecimalAddFunction() function.Function {
+	return &DecimalAddFunction{}
+}
+
+func NewDecimalSubFunction() function.Function {
+	return &DecimalSubFunction{}
+}
+
+func NewDecimalCmpFunction() function.Function {
+	return &DecimalCmpFunction{}
+}
+
+func NewDecimalRoundFunction() function.Function {
+	return &DecimalRoundFunction{}
+}
+
+func NewMoneyFormatFunction() function.Function {
+	return &MoneyFormatFunction{}
+}
+
+type DecimalAddFunction struct{}
+
+type DecimalSubFunction struct{}
+
+type DecimalCmpFunction struct{}
+
+type DecimalRoundFunction struct{}
+
+type MoneyFormatFunction struct{}
+
+func decimalParameter(name, description string) function.StringParameter {
+	return function.StringParameter{
+		Name:                name,
+		MarkdownDescription: description + " Given as a string such as `\"0.00000001\"`.",
+	}
+}
+
+func currencyParameter() function.StringParameter {
+	return function.StringParameter{
+		Name: "currency",
+		MarkdownDescription: "Currency code. Decides the number of decimal places, two unless the currency is known to use " +
+			"another number, e.g. 0 for `JPY` and 8 for `BTC`. Other crypto currencies take the number listed in the " +
+			"`CALA_CRYPTO_CURRENCIES` environment variable, e.g. `SOL:9`.",
+	}
+}
+
+// parseDecimalArgument parses the decimal at the given argument position.
+func parseDecimalArgument(position int64, value string) (decimal.Decimal, *function.FuncError) {
+	d, err := decimal.NewFromString(strings.TrimSpace(value))
+	if err != nil {
+		return decimal.Decimal{}, function.NewArgumentFuncError(position, fmt.Sprintf("Invalid decimal %q.", value))
+	}
+	return d, nil
+}
+
+// runDecimalOperation reads two decimal arguments and sets the result of op.
+func runDecimalOperation(ctx context.Context, req function.RunRequest, resp *function.RunResponse, op func(a, b decimal.Decimal) decimal.Decimal) {
+	var a, b string
+
+	resp.Error = req.Arguments.Get(ctx, &a, &b)
+
+	if resp.Error != nil {
+		return
+	}
+
+	x, funcErr := parseDecimalArgument(0, a)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	y, funcErr := parseDecimalArgument(1, b)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, op(x, y).String())
+}
+
+func (f *DecimalAddFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "decimal_add"
+}
+
+func (f *DecimalAddFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Add two decimals",
+		MarkdownDescription: "Returns `a + b`, computed without loss of precision.",
+		Parameters: []function.Parameter{
+			decimalParameter("a", "First decimal."),
+			decimalParameter("b", "Second decimal."),
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *DecimalAddFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	runDecimalOperation(ctx, req, resp, decimal.Decimal.Add)
+}
+
+func (f *DecimalSubFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "decimal_sub"
+}
+
+func (f *DecimalSubFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Subtract two decimals",
+		MarkdownDescription: "Returns `a - b`, computed without loss of precision.",
+		Parameters: []function.Parameter{
+			decimalParameter("a", "Decimal to subtract from."),
+			decimalParameter("b", "Decimal to subtract."),
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *DecimalSubFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	runDecimalOperation(ctx, req, resp, decimal.Decimal.Sub)
+}
+
+func (f *DecimalCmpFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "decimal_cmp"
+}
+
+func (f *DecimalCmpFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Compare two decimals",
+		MarkdownDescription: "Returns -1 when `a` is less than `b`, 0 when they are equal and 1 when `a` is greater than `b`.",
+		Parameters: []function.Parameter{
+			decimalParameter("a", "First decimal."),
+			decimalParameter("b", "Second decimal."),
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *DecimalCmpFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b string
+
+	resp.Error = req.Arguments.Get(ctx, &a, &b)
+
+	if resp.Error != nil {
+		return
+	}
+
+	x, funcErr := parseDecimalArgument(0, a)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	y, funcErr := parseDecimalArgument(1, b)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, int64(x.Cmp(y)))
+}
+
+func (f *DecimalRoundFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "decimal_round"
+}
+
+func (f *DecimalRoundFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Round a decimal to the minor units of a currency",
+		MarkdownDescription: "Returns `value` rounded half away from zero to the number of decimal places of `currency`, " +
+			"with trailing zeros, e.g. `\"1.50\"` for `USD`.",
+		Parameters: []function.Parameter{
+			decimalParameter("value", "Decimal to round."),
+			currencyParameter(),
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *DecimalRoundFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value, currency string
+
+	resp.Error = req.Arguments.Get(ctx, &value, &currency)
+
+	if resp.Error != nil {
+		return
+	}
+
+	d, funcErr := parseDecimalArgument(0, value)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	exponent, err := currencyExponent(currency)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid currency: %s.", err))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, d.StringFixed(exponent))
+}
+
+func (f *MoneyFormatFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "money_format"
+}
+
+func (f *MoneyFormatFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Format an amount of money",
+		MarkdownDescription: "Returns `units` rounded like `decimal_round`, with thousands separators and followed by the " +
+			"currency code, e.g. `\"1,234.50 USD\"`.",
+		Parameters: []function.Parameter{
+			decimalParameter("units", "Amount of money."),
+			currencyParameter(),
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *MoneyFormatFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var units, currency string
+
+	resp.Error = req.Arguments.Get(ctx, &units, &currency)
+
+	if resp.Error != nil {
+		return
+	}
+
+	d, funcErr := parseDecimalArgument(0, units)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	exponent, err := currencyExponent(currency)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid currency: %s.", err))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, formatMoney(d, exponent)+" "+currency)
+}
+
+// formatMoney rounds d to exponent decimal places and groups the digits of
+// the integer part by thousands.
+func formatMoney(d decimal.Decimal, exponent int32) string {
+	fixed := d.Abs().StringFixed(exponent)
+
+	integer, fraction, hasFraction := strings.Cut(fixed, ".")
+
+	var grouped strings.Builder
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			grouped.WriteByte(',')
+		}
+		grouped.WriteRune(digit)
+	}
+
+	result := grouped.String()
+	if hasFraction {
+		result += "." + fraction
+	}
+
+	if d.Round(exponent).IsNegative() {
+		result = "-" + result
+	}
+
+	return result
+}
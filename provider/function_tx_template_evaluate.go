@@ -0,0 +1,418 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shopspring/decimal"
+)
+
+var _ function.Function = &TxTemplateEvaluateFunction{}
+
+func NewTxTemplateEvaluateFunction() function.Function {
+	return &TxTemplateEvaluateFunction{}
+}
+
+// TxTemplateEvaluateFunction evaluates a tx template definition against
+// sample params without contacting the server.
+type TxTemplateEvaluateFunction struct{}
+
+type TxTemplateEvaluationModel struct {
+	Transaction TxTemplateEvaluatedTransactionModel `tfsdk:"transaction"`
+	Entries     []TxTemplateEvaluatedEntryModel     `tfsdk:"entries"`
+	Balances    []TxTemplateEvaluatedBalanceModel   `tfsdk:"balances"`
+	Balanced    bool                                `tfsdk:"balanced"`
+}
+
+type TxTemplateEvaluatedTransactionModel struct {
+	Effective     string  `tfsdk:"effective"`
+	JournalId     string  `tfsdk:"journal_id"`
+	CorrelationId *string `tfsdk:"correlation_id"`
+	ExternalId    *string `tfsdk:"external_id"`
+	Description   *string `tfsdk:"description"`
+	Metadata      *string `tfsdk:"metadata"`
+}
+
+type TxTemplateEvaluatedEntryModel struct {
+	EntryType   string  `tfsdk:"entry_type"`
+	AccountId   string  `tfsdk:"account_id"`
+	Layer       string  `tfsdk:"layer"`
+	Direction   string  `tfsdk:"direction"`
+	Units       string  `tfsdk:"units"`
+	Currency    string  `tfsdk:"currency"`
+	Description *string `tfsdk:"description"`
+}
+
+type TxTemplateEvaluatedBalanceModel struct {
+	Currency string `tfsdk:"currency"`
+	Layer    string `tfsdk:"layer"`
+	Debits   string `tfsdk:"debits"`
+	Credits  string `tfsdk:"credits"`
+	Balanced bool   `tfsdk:"balanced"`
+}
+
+func (f *TxTemplateEvaluateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tx_template_evaluate"
+}
+
+func (f *TxTemplateEvaluateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Evaluate a tx template against sample params",
+		MarkdownDescription: "Evaluates the expressions of a tx template locally, without posting to Cala, and returns the " +
+			"resulting transaction and entries. `balances` sums debits and credits per currency and layer, `balanced` is " +
+			"false when any of them differ. `date()` and `timestamp()` without arguments evaluate to `evaluated_at`, so " +
+			"that the result only depends on the arguments.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name: "template",
+				MarkdownDescription: "Tx template definition, either a `cala_tx_template` resource or data source, or an object " +
+					"in the `TxTemplateCreateInput` shape with `params`, `transaction` and `entries`.",
+			},
+			function.DynamicParameter{
+				Name:                "params",
+				MarkdownDescription: "Object of sample param values, keyed by param name.",
+				AllowNullValue:      true,
+			},
+			function.StringParameter{
+				Name: "evaluated_at",
+				MarkdownDescription: "RFC 3339 timestamp the template is evaluated at, used by `date()` and `timestamp()` " +
+					"without arguments, e.g. `plantimestamp()`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"transaction": types.ObjectType{AttrTypes: map[string]attr.Type{
+					"effective":      types.StringType,
+					"journal_id":     types.StringType,
+					"correlation_id": types.StringType,
+					"external_id":    types.StringType,
+					"description":    types.StringType,
+					"metadata":       types.StringType,
+				}},
+				"entries": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+					"entry_type":  types.StringType,
+					"account_id":  types.StringType,
+					"layer":       types.StringType,
+					"direction":   types.StringType,
+					"units":       types.StringType,
+					"currency":    types.StringType,
+					"description": types.StringType,
+				}}},
+				"balances": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+					"currency": types.StringType,
+					"layer":    types.StringType,
+					"debits":   types.StringType,
+					"credits":  types.StringType,
+					"balanced": types.BoolType,
+				}}},
+				"balanced": types.BoolType,
+			},
+		},
+	}
+}
+
+func (f *TxTemplateEvaluateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var templateArg, paramsArg types.Dynamic
+	var evaluatedAtArg string
+
+	resp.Error = req.Arguments.Get(ctx, &templateArg, &paramsArg, &evaluatedAtArg)
+
+	if resp.Error != nil {
+		return
+	}
+
+	evaluatedAt, err := time.Parse(time.RFC3339, evaluatedAtArg)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("Invalid evaluated_at: %s", err))
+		return
+	}
+	now := func() time.Time { return evaluatedAt }
+
+	template, err := txTemplateInputFromDynamic(templateArg)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid tx template: %s", err))
+		return
+	}
+
+	var sample map[string]interface{}
+	if !paramsArg.IsNull() && !paramsArg.IsUnderlyingValueNull() {
+		value, err := attrToInterface(paramsArg.UnderlyingValue())
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid params: %s", err))
+			return
+		}
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			resp.Error = function.NewArgumentFuncError(1, "Invalid params: params must be an object")
+			return
+		}
+		sample = m
+	}
+
+	params, err := txTemplateParamValues(template.Params, sample, now)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid params: %s", err))
+		return
+	}
+
+	result, err := evaluateTxTemplate(template, &exprEvaluator{params: params, now: now})
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to evaluate tx template: %s", err))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
+
+// txTemplateInputFromDynamic decodes a tx template definition. Attribute names
+// may be given in snake_case, as in the cala_tx_template resource, or in the
+// camelCase of TxTemplateCreateInput.
+func txTemplateInputFromDynamic(value types.Dynamic) (*TxTemplateCreateInput, error) {
+	decoded, err := attrToInterface(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := decoded.(map[string]interface{}); !ok {
+		return nil, fmt.Errorf("template must be an object")
+	}
+
+	encoded, err := json.Marshal(camelCaseKeys(decoded))
+	if err != nil {
+		return nil, err
+	}
+
+	var template TxTemplateCreateInput
+	if err := json.Unmarshal(encoded, &template); err != nil {
+		return nil, err
+	}
+
+	return &template, nil
+}
+
+// camelCaseKeys rewrites snake_case object keys to camelCase. Metadata is
+// user data and is left untouched.
+func camelCaseKeys(value interface{}) interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, element := range v {
+			result[i] = camelCaseKeys(element)
+		}
+		return result
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, element := range v {
+			if key != "metadata" {
+				element = camelCaseKeys(element)
+			}
+			parts := strings.Split(key, "_")
+			for i := 1; i < len(parts); i++ {
+				if parts[i] != "" {
+					parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+				}
+			}
+			result[strings.Join(parts, "")] = element
+		}
+		return result
+	}
+	return value
+}
+
+// txTemplateParamValues resolves the value of each declared param from the
+// sample params, falling back to the default expression of the param.
+func txTemplateParamValues(definitions []ParamDefinitionInput, sample map[string]interface{}, now func() time.Time) (map[string]interface{}, error) {
+	declared := make(map[string]bool, len(definitions))
+	params := make(map[string]interface{}, len(definitions))
+	defaults := &exprEvaluator{params: map[string]interface{}{}, now: now}
+
+	for _, definition := range definitions {
+		declared[definition.Name] = true
+
+		if raw, ok := sample[definition.Name]; ok && raw != nil {
+			value, err := paramValue(definition.Type, raw)
+			if err != nil {
+				return nil, fmt.Errorf("params.%s: %w", definition.Name, err)
+			}
+			params[definition.Name] = value
+			continue
+		}
+
+		if definition.Default == nil {
+			continue
+		}
+
+		value, err := defaults.evalExpression(*definition.Default)
+		if err != nil {
+			return nil, fmt.Errorf("default of params.%s: %w", definition.Name, err)
+		}
+		params[definition.Name] = value
+	}
+
+	for _, name := range sortedKeys(sample) {
+		if !declared[name] {
+			return nil, fmt.Errorf("params.%s is not declared by the tx template", name)
+		}
+	}
+
+	return params, nil
+}
+
+// evaluateTxTemplate evaluates the transaction and entry expressions of a tx
+// template and checks that the entries balance per currency and layer.
+func evaluateTxTemplate(template *TxTemplateCreateInput, evaluator *exprEvaluator) (*TxTemplateEvaluationModel, error) {
+	// evalOptional evaluates an expression and checks its result against
+	// expected, the same expectation the cala_tx_template resource checks.
+	// Expressions evaluating to null result in nil.
+	evalOptional := func(field string, source *string, expected exprExpectation) (*string, error) {
+		if source == nil {
+			return nil, nil
+		}
+		value, err := evaluator.evalExpression(*source)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", field, err)
+		}
+		if value == nil {
+			return nil, nil
+		}
+		actual := exprValueType(value)
+		matches := false
+		for _, t := range expected.types {
+			if actual == t || (t == exprTypeDecimal && actual == exprTypeInt) {
+				matches = true
+				break
+			}
+		}
+		if !matches {
+			return nil, fmt.Errorf("%s: expression evaluates to %s but %s is expected", field, actual, expected)
+		}
+		result := formatExprValue(value)
+		if actual == exprTypeString {
+			if len(expected.values) > 0 {
+				allowed := false
+				for _, v := range expected.values {
+					allowed = allowed || result == v
+				}
+				if !allowed {
+					return nil, fmt.Errorf("%s: %q is not one of %s", field, result, strings.Join(expected.values, ", "))
+				}
+			}
+			if expected.literal != nil {
+				if err := expected.literal(result); err != nil {
+					return nil, fmt.Errorf("%s: %q is not valid: %s", field, result, err)
+				}
+			}
+		}
+		return &result, nil
+	}
+
+	evalString := func(field, source string, expected exprExpectation) (string, error) {
+		result, err := evalOptional(field, &source, expected)
+		if err != nil {
+			return "", err
+		}
+		if result == nil {
+			return "", fmt.Errorf("%s: expression evaluates to null but %s is expected", field, expected)
+		}
+		return *result, nil
+	}
+
+	result := &TxTemplateEvaluationModel{
+		Entries:  make([]TxTemplateEvaluatedEntryModel, 0, len(template.Entries)),
+		Balances: []TxTemplateEvaluatedBalanceModel{},
+		Balanced: true,
+	}
+
+	transaction := &result.Transaction
+	var err error
+
+	if transaction.Effective, err = evalString("transaction.effective", template.Transaction.Effective, txTemplateTransactionExpectations["effective"]); err != nil {
+		return nil, err
+	}
+	if transaction.JournalId, err = evalString("transaction.journal_id", template.Transaction.JournalId, txTemplateTransactionExpectations["journal_id"]); err != nil {
+		return nil, err
+	}
+	if transaction.CorrelationId, err = evalOptional("transaction.correlation_id", template.Transaction.CorrelationId, txTemplateTransactionExpectations["correlation_id"]); err != nil {
+		return nil, err
+	}
+	if transaction.ExternalId, err = evalOptional("transaction.external_id", template.Transaction.ExternalId, txTemplateTransactionExpectations["external_id"]); err != nil {
+		return nil, err
+	}
+	if transaction.Description, err = evalOptional("transaction.description", template.Transaction.Description, txTemplateTransactionExpectations["description"]); err != nil {
+		return nil, err
+	}
+	if transaction.Metadata, err = evalOptional("transaction.metadata", template.Transaction.Metadata, txTemplateTransactionExpectations["metadata"]); err != nil {
+		return nil, err
+	}
+
+	type balanceKey struct{ currency, layer string }
+	var order []balanceKey
+	debits := map[balanceKey]decimal.Decimal{}
+	credits := map[balanceKey]decimal.Decimal{}
+
+	for i, entryTemplate := range template.Entries {
+		field := func(name string) string { return fmt.Sprintf("entries[%d].%s", i, name) }
+		entry := TxTemplateEvaluatedEntryModel{}
+
+		if entry.EntryType, err = evalString(field("entry_type"), entryTemplate.EntryType, txTemplateEntryExpectations["entry_type"]); err != nil {
+			return nil, err
+		}
+		if entry.AccountId, err = evalString(field("account_id"), entryTemplate.AccountId, txTemplateEntryExpectations["account_id"]); err != nil {
+			return nil, err
+		}
+		if entry.Layer, err = evalString(field("layer"), entryTemplate.Layer, txTemplateEntryExpectations["layer"]); err != nil {
+			return nil, err
+		}
+		if entry.Direction, err = evalString(field("direction"), entryTemplate.Direction, txTemplateEntryExpectations["direction"]); err != nil {
+			return nil, err
+		}
+		if entry.Units, err = evalString(field("units"), entryTemplate.Units, txTemplateEntryExpectations["units"]); err != nil {
+			return nil, err
+		}
+		if entry.Currency, err = evalString(field("currency"), entryTemplate.Currency, txTemplateEntryExpectations["currency"]); err != nil {
+			return nil, err
+		}
+		if entry.Description, err = evalOptional(field("description"), entryTemplate.Description, txTemplateEntryExpectations["description"]); err != nil {
+			return nil, err
+		}
+
+		units, err := decimal.NewFromString(entry.Units)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %q is not a valid decimal", field("units"), entry.Units)
+		}
+
+		key := balanceKey{entry.Currency, entry.Layer}
+		if _, ok := debits[key]; !ok {
+			order = append(order, key)
+			debits[key] = decimal.Zero
+			credits[key] = decimal.Zero
+		}
+		if entry.Direction == "DEBIT" {
+			debits[key] = debits[key].Add(units)
+		} else {
+			credits[key] = credits[key].Add(units)
+		}
+
+		result.Entries = append(result.Entries, entry)
+	}
+
+	for _, key := range order {
+		balanced := debits[key].Equal(credits[key])
+		result.Balanced = result.Balanced && balanced
+		result.Balances = append(result.Balances, TxTemplateEvaluatedBalanceModel{
+			Currency: key.currency,
+			Layer:    key.layer,
+			Debits:   debits[key].String(),
+			Credits:  credits[key].String(),
+			Balanced: balanced,
+		})
+	}
+
+	return result, nil
+}
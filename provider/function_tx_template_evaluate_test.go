@@ -0,0 +1,322 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var testEvaluatedAt = time.Date(2024, 2, 29, 10, 30, 0, 0, time.UTC)
+
+func testEvaluatedAtNow() time.Time { return testEvaluatedAt }
+
+func stringPointer(s string) *string { return &s }
+
+func testEntry(accountId, layer, direction, units, currency string) TxTemplateEntryInput {
+	return TxTemplateEntryInput{
+		EntryType: "'ENTRY'",
+		AccountId: "'" + accountId + "'",
+		Layer:     layer,
+		Direction: direction,
+		Units:     units,
+		Currency:  currency,
+	}
+}
+
+func TestTxTemplateParamValues(t *testing.T) {
+	definitions := []ParamDefinitionInput{
+		{Name: "amount", Type: ParamDataTypeDecimal},
+		{Name: "count", Type: ParamDataTypeInteger, Default: stringPointer("1")},
+		{Name: "effective", Type: ParamDataTypeDate, Default: stringPointer("date()")},
+		{Name: "accountId", Type: ParamDataTypeUuid},
+	}
+
+	params, err := txTemplateParamValues(definitions, map[string]interface{}{
+		"amount":    json.Number("10.50"),
+		"accountId": "B9A5EA5C-6BA6-4A29-A0E6-8B8EA7A64E5C",
+	}, testEvaluatedAtNow)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{
+		"amount":    "10.5",
+		"count":     "1",
+		"effective": "2024-02-29",
+		"accountId": "b9a5ea5c-6ba6-4a29-a0e6-8b8ea7a64e5c",
+	}
+
+	for name, value := range expected {
+		if result := formatExprValue(params[name]); result != value {
+			t.Errorf("params.%s: expected %s, got %s", name, value, result)
+		}
+	}
+
+	// Params without a value nor a default are left out, evaluating an
+	// expression using them fails.
+	params, err = txTemplateParamValues(definitions, nil, testEvaluatedAtNow)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := params["amount"]; ok {
+		t.Errorf("expected params.amount to be omitted, got %v", params["amount"])
+	}
+
+	cases := []struct {
+		definitions []ParamDefinitionInput
+		sample      map[string]interface{}
+		err         string
+	}{
+		{definitions, map[string]interface{}{"amount": "ten"}, "params.amount: ten is not a valid DECIMAL"},
+		{definitions, map[string]interface{}{"accountId": "not a uuid"}, "params.accountId"},
+		{definitions, map[string]interface{}{"count": json.Number("1.5")}, "params.count"},
+		{definitions, map[string]interface{}{"unknown": "value"}, "params.unknown is not declared"},
+		{[]ParamDefinitionInput{{Name: "amount", Type: ParamDataTypeDecimal, Default: stringPointer("decimal(")}}, nil, "default of params.amount"},
+	}
+
+	for _, c := range cases {
+		_, err := txTemplateParamValues(c.definitions, c.sample, testEvaluatedAtNow)
+		if err == nil || !strings.Contains(err.Error(), c.err) {
+			t.Errorf("%v: expected an error containing %q, got %v", c.sample, c.err, err)
+		}
+	}
+}
+
+func TestEvaluateTxTemplate(t *testing.T) {
+	const (
+		cash     = "00000000-0000-0000-0000-000000000001"
+		deposits = "00000000-0000-0000-0000-000000000002"
+	)
+
+	transaction := TxTemplateTransactionInput{
+		Effective:   "date()",
+		JournalId:   "'b9a5ea5c-6ba6-4a29-a0e6-8b8ea7a64e5c'",
+		Description: stringPointer("'Deposit of ' + string(params.amount)"),
+	}
+
+	evaluator := &exprEvaluator{
+		params: map[string]interface{}{"amount": decimalParam(t, "10.50")},
+		now:    testEvaluatedAtNow,
+	}
+
+	type balance struct {
+		currency, layer, debits, credits string
+		balanced                         bool
+	}
+
+	cases := []struct {
+		name     string
+		entries  []TxTemplateEntryInput
+		balances []balance
+		balanced bool
+		err      string
+	}{
+		{
+			name: "balanced",
+			entries: []TxTemplateEntryInput{
+				testEntry(cash, "SETTLED", "DEBIT", "params.amount", "'USD'"),
+				testEntry(deposits, "SETTLED", "CREDIT", "params.amount", "'USD'"),
+			},
+			balances: []balance{{"USD", "SETTLED", "10.5", "10.5", true}},
+			balanced: true,
+		},
+		{
+			name: "unbalanced",
+			entries: []TxTemplateEntryInput{
+				testEntry(cash, "SETTLED", "DEBIT", "params.amount", "'USD'"),
+				testEntry(deposits, "SETTLED", "CREDIT", "params.amount - decimal('0.5')", "'USD'"),
+			},
+			balances: []balance{{"USD", "SETTLED", "10.5", "10", false}},
+			balanced: false,
+		},
+		{
+			name: "multiple currencies and layers",
+			entries: []TxTemplateEntryInput{
+				testEntry(cash, "SETTLED", "DEBIT", "params.amount", "'USD'"),
+				testEntry(deposits, "SETTLED", "CREDIT", "params.amount", "'USD'"),
+				testEntry(cash, "PENDING", "DEBIT", "decimal('0.001')", "'BTC'"),
+				testEntry(deposits, "PENDING", "CREDIT", "decimal('0.001')", "'BTC'"),
+				testEntry(cash, "ENCUMBRANCE", "DEBIT", "decimal('5')", "'USD'"),
+			},
+			balances: []balance{
+				{"USD", "SETTLED", "10.5", "10.5", true},
+				{"BTC", "PENDING", "0.001", "0.001", true},
+				{"USD", "ENCUMBRANCE", "5", "0", false},
+			},
+			balanced: false,
+		},
+		{
+			name:    "invalid expression",
+			entries: []TxTemplateEntryInput{testEntry(cash, "SETTLED", "DEBIT", "params.missing", "'USD'")},
+			err:     "entries[0].units",
+		},
+		{
+			name:    "invalid layer",
+			entries: []TxTemplateEntryInput{testEntry(cash, "'FINAL'", "DEBIT", "params.amount", "'USD'")},
+			err:     `entries[0].layer: "FINAL" is not one of`,
+		},
+		{
+			name:    "invalid account",
+			entries: []TxTemplateEntryInput{testEntry("cash", "SETTLED", "DEBIT", "params.amount", "'USD'")},
+			err:     "entries[0].account_id",
+		},
+	}
+
+	for _, c := range cases {
+		result, err := evaluateTxTemplate(&TxTemplateCreateInput{Transaction: transaction, Entries: c.entries}, evaluator)
+
+		if c.err != "" {
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Errorf("%s: expected an error containing %q, got %v", c.name, c.err, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", c.name, err)
+			continue
+		}
+
+		if result.Transaction.Effective != "2024-02-29" || result.Transaction.Description == nil || *result.Transaction.Description != "Deposit of 10.5" {
+			t.Errorf("%s: unexpected transaction %+v", c.name, result.Transaction)
+		}
+
+		if len(result.Entries) != len(c.entries) {
+			t.Errorf("%s: expected %d entries, got %d", c.name, len(c.entries), len(result.Entries))
+		}
+
+		if result.Balanced != c.balanced {
+			t.Errorf("%s: expected balanced to be %t", c.name, c.balanced)
+		}
+
+		if len(result.Balances) != len(c.balances) {
+			t.Errorf("%s: expected %d balances, got %+v", c.name, len(c.balances), result.Balances)
+			continue
+		}
+
+		for i, expected := range c.balances {
+			actual := result.Balances[i]
+			if actual.Currency != expected.currency || actual.Layer != expected.layer || actual.Debits != expected.debits ||
+				actual.Credits != expected.credits || actual.Balanced != expected.balanced {
+				t.Errorf("%s: expected balance %+v, got %+v", c.name, expected, actual)
+			}
+		}
+	}
+}
+
+// decimalParam converts a sample decimal param the way
+// txTemplateParamValues does.
+func decimalParam(t *testing.T, value string) interface{} {
+	t.Helper()
+
+	v, err := paramValue(ParamDataTypeDecimal, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return v
+}
+
+func TestTxTemplateEvaluateFunction(t *testing.T) {
+	ctx := context.Background()
+	f := &TxTemplateEvaluateFunction{}
+
+	definition := &function.DefinitionResponse{}
+	f.Definition(ctx, function.DefinitionRequest{}, definition)
+	resultTypes := definition.Definition.Return.(function.ObjectReturn).AttributeTypes
+
+	object := func(values map[string]attr.Value) types.Object {
+		attrTypes := make(map[string]attr.Type, len(values))
+		for name, value := range values {
+			attrTypes[name] = value.Type(ctx)
+		}
+		return types.ObjectValueMust(attrTypes, values)
+	}
+
+	entry := func(accountId, direction string) attr.Value {
+		return object(map[string]attr.Value{
+			"entry_type": types.StringValue("'DEPOSIT'"),
+			"account_id": types.StringValue("'" + accountId + "'"),
+			"layer":      types.StringValue("SETTLED"),
+			"direction":  types.StringValue(direction),
+			"units":      types.StringValue("params.amount"),
+			"currency":   types.StringValue("params.currency"),
+		})
+	}
+
+	// The template in the snake_case shape of the cala_tx_template resource.
+	template := types.DynamicValue(object(map[string]attr.Value{
+		"params": types.TupleValueMust(
+			[]attr.Type{types.ObjectType{AttrTypes: map[string]attr.Type{"name": types.StringType, "type": types.StringType}}, types.ObjectType{AttrTypes: map[string]attr.Type{"name": types.StringType, "type": types.StringType, "default": types.StringType}}},
+			[]attr.Value{
+				object(map[string]attr.Value{"name": types.StringValue("amount"), "type": types.StringValue("DECIMAL")}),
+				object(map[string]attr.Value{"name": types.StringValue("currency"), "type": types.StringValue("STRING"), "default": types.StringValue("'USD'")}),
+			},
+		),
+		"transaction": object(map[string]attr.Value{
+			"effective":  types.StringValue("date()"),
+			"journal_id": types.StringValue("'b9a5ea5c-6ba6-4a29-a0e6-8b8ea7a64e5c'"),
+		}),
+		"entries": types.TupleValueMust(
+			[]attr.Type{entry("a", "DEBIT").Type(ctx), entry("a", "CREDIT").Type(ctx)},
+			[]attr.Value{
+				entry("00000000-0000-0000-0000-000000000001", "DEBIT"),
+				entry("00000000-0000-0000-0000-000000000002", "CREDIT"),
+			},
+		),
+	}))
+
+	run := func(params types.Dynamic, evaluatedAt string) (*function.RunResponse, *TxTemplateEvaluationModel) {
+		resp := &function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(resultTypes))}
+		f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{template, params, types.StringValue(evaluatedAt)})}, resp)
+
+		if resp.Error != nil {
+			return resp, nil
+		}
+
+		var result *TxTemplateEvaluationModel
+		if diags := resp.Result.Value().(types.Object).As(ctx, &result, basetypes.ObjectAsOptions{}); diags.HasError() {
+			t.Fatal(diags)
+		}
+		return resp, result
+	}
+
+	amount := types.DynamicValue(object(map[string]attr.Value{"amount": types.StringValue("12.34")}))
+
+	_, result := run(amount, "2024-02-29T10:30:00Z")
+	if result == nil {
+		t.Fatal("expected the template to be evaluated")
+	}
+
+	if !result.Balanced || result.Transaction.Effective != "2024-02-29" || len(result.Entries) != 2 ||
+		result.Entries[0].Units != "12.34" || result.Entries[0].Currency != "USD" {
+		t.Errorf("unexpected result %+v", result)
+	}
+
+	cases := []struct {
+		name        string
+		params      types.Dynamic
+		evaluatedAt string
+		err         string
+	}{
+		{"invalid evaluated_at", amount, "yesterday", "Invalid evaluated_at"},
+		{"undeclared param", types.DynamicValue(object(map[string]attr.Value{"amount": types.StringValue("1"), "fee": types.StringValue("1")})), "2024-02-29T10:30:00Z", "params.fee is not declared"},
+		{"invalid param", types.DynamicValue(object(map[string]attr.Value{"amount": types.StringValue("ten")})), "2024-02-29T10:30:00Z", "Invalid params"},
+		{"params not an object", types.DynamicValue(types.StringValue("12.34")), "2024-02-29T10:30:00Z", "params must be an object"},
+		{"missing param", types.DynamicNull(), "2024-02-29T10:30:00Z", "Unable to evaluate tx template"},
+	}
+
+	for _, c := range cases {
+		resp, _ := run(c.params, c.evaluatedAt)
+		if resp.Error == nil || !strings.Contains(resp.Error.Error(), c.err) {
+			t.Errorf("%s: expected an error containing %q, got %v", c.name, c.err, resp.Error)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &UuidFromCodeFunction{}
+
+func NewUuidFromCodeFunction() function.Function {
+	return &UuidFromCodeFunction{}
+}
+
+// UuidFromCodeFunction derives a version 5 UUID from a namespace and a code,
+// so IDs are the same in every environment.
+type UuidFromCodeFunction struct{}
+
+// uuidNamespaces are the well-known namespaces of RFC 4122 that can be named
+// instead of given as a UUID.
+var uuidNamespaces = map[string]uuid.UUID{
+	"dns":  uuid.NameSpaceDNS,
+	"url":  uuid.NameSpaceURL,
+	"oid":  uuid.NameSpaceOID,
+	"x500": uuid.NameSpaceX500,
+}
+
+func (f *UuidFromCodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "uuid_from_code"
+}
+
+func (f *UuidFromCodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Derive a stable UUID from a code",
+		MarkdownDescription: "Returns the version 5 UUID of `code` in `namespace`, as defined by RFC 4122. The same namespace " +
+			"and code always give the same UUID, so IDs of accounts, journals, account sets and tx templates can be " +
+			"reproduced in every environment and from application code.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name: "namespace",
+				MarkdownDescription: "Namespace UUID, or one of the RFC 4122 namespaces `dns`, `url`, `oid` and `x500`. " +
+					"Use a namespace of your own to avoid collisions with other systems.",
+			},
+			function.StringParameter{
+				Name:                "code",
+				MarkdownDescription: "Code the UUID is derived from.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *UuidFromCodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var namespace, code string
+
+	resp.Error = req.Arguments.Get(ctx, &namespace, &code)
+
+	if resp.Error != nil {
+		return
+	}
+
+	space, ok := uuidNamespaces[namespace]
+	if !ok {
+		var err error
+		space, err = uuid.Parse(namespace)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid namespace %q: must be a UUID or one of dns, url, oid and x500.", namespace))
+			return
+		}
+	}
+
+	resp.Error = resp.Result.Set(ctx, uuid.NewSHA1(space, []byte(code)).String())
+}
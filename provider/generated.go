@@ -5,6 +5,7 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/Khan/genqlient/graphql"
 )
@@ -181,6 +182,25 @@ func (v *BigQueryIntegrationCreateInput) GetServiceAccountCredsBase64() string {
 	return v.ServiceAccountCredsBase64
 }
 
+type CalaOutboxImportJobCreateInput struct {
+	JobId       string  `json:"jobId"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	Endpoint    string  `json:"endpoint"`
+}
+
+// GetJobId returns CalaOutboxImportJobCreateInput.JobId, and is useful for accessing the field via an interface.
+func (v *CalaOutboxImportJobCreateInput) GetJobId() string { return v.JobId }
+
+// GetName returns CalaOutboxImportJobCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *CalaOutboxImportJobCreateInput) GetName() string { return v.Name }
+
+// GetDescription returns CalaOutboxImportJobCreateInput.Description, and is useful for accessing the field via an interface.
+func (v *CalaOutboxImportJobCreateInput) GetDescription() *string { return v.Description }
+
+// GetEndpoint returns CalaOutboxImportJobCreateInput.Endpoint, and is useful for accessing the field via an interface.
+func (v *CalaOutboxImportJobCreateInput) GetEndpoint() string { return v.Endpoint }
+
 type DebitOrCredit string
 
 const (
@@ -222,6 +242,38 @@ func (v *JournalUpdateInput) GetStatus() *Status { return v.Status }
 // GetDescription returns JournalUpdateInput.Description, and is useful for accessing the field via an interface.
 func (v *JournalUpdateInput) GetDescription() *string { return v.Description }
 
+type ParamDataType string
+
+const (
+	ParamDataTypeString    ParamDataType = "STRING"
+	ParamDataTypeInteger   ParamDataType = "INTEGER"
+	ParamDataTypeDecimal   ParamDataType = "DECIMAL"
+	ParamDataTypeBoolean   ParamDataType = "BOOLEAN"
+	ParamDataTypeUuid      ParamDataType = "UUID"
+	ParamDataTypeDate      ParamDataType = "DATE"
+	ParamDataTypeTimestamp ParamDataType = "TIMESTAMP"
+	ParamDataTypeJson      ParamDataType = "JSON"
+)
+
+type ParamDefinitionInput struct {
+	Name        string        `json:"name"`
+	Type        ParamDataType `json:"type"`
+	Default     *string       `json:"default"`
+	Description *string       `json:"description"`
+}
+
+// GetName returns ParamDefinitionInput.Name, and is useful for accessing the field via an interface.
+func (v *ParamDefinitionInput) GetName() string { return v.Name }
+
+// GetType returns ParamDefinitionInput.Type, and is useful for accessing the field via an interface.
+func (v *ParamDefinitionInput) GetType() ParamDataType { return v.Type }
+
+// GetDefault returns ParamDefinitionInput.Default, and is useful for accessing the field via an interface.
+func (v *ParamDefinitionInput) GetDefault() *string { return v.Default }
+
+// GetDescription returns ParamDefinitionInput.Description, and is useful for accessing the field via an interface.
+func (v *ParamDefinitionInput) GetDescription() *string { return v.Description }
+
 type Status string
 
 const (
@@ -229,6 +281,126 @@ const (
 	StatusLocked Status = "LOCKED"
 )
 
+type TransactionInput struct {
+	TransactionId  string           `json:"transactionId"`
+	TxTemplateCode string           `json:"txTemplateCode"`
+	Params         *json.RawMessage `json:"params"`
+}
+
+// GetTransactionId returns TransactionInput.TransactionId, and is useful for accessing the field via an interface.
+func (v *TransactionInput) GetTransactionId() string { return v.TransactionId }
+
+// GetTxTemplateCode returns TransactionInput.TxTemplateCode, and is useful for accessing the field via an interface.
+func (v *TransactionInput) GetTxTemplateCode() string { return v.TxTemplateCode }
+
+// GetParams returns TransactionInput.Params, and is useful for accessing the field via an interface.
+func (v *TransactionInput) GetParams() *json.RawMessage { return v.Params }
+
+type TxTemplateCreateInput struct {
+	TxTemplateId string                     `json:"txTemplateId"`
+	Code         string                     `json:"code"`
+	Params       []ParamDefinitionInput     `json:"params"`
+	Transaction  TxTemplateTransactionInput `json:"transaction"`
+	Entries      []TxTemplateEntryInput     `json:"entries"`
+	Description  *string                    `json:"description"`
+	Metadata     *json.RawMessage           `json:"metadata"`
+}
+
+// GetTxTemplateId returns TxTemplateCreateInput.TxTemplateId, and is useful for accessing the field via an interface.
+func (v *TxTemplateCreateInput) GetTxTemplateId() string { return v.TxTemplateId }
+
+// GetCode returns TxTemplateCreateInput.Code, and is useful for accessing the field via an interface.
+func (v *TxTemplateCreateInput) GetCode() string { return v.Code }
+
+// GetParams returns TxTemplateCreateInput.Params, and is useful for accessing the field via an interface.
+func (v *TxTemplateCreateInput) GetParams() []ParamDefinitionInput { return v.Params }
+
+// GetTransaction returns TxTemplateCreateInput.Transaction, and is useful for accessing the field via an interface.
+func (v *TxTemplateCreateInput) GetTransaction() TxTemplateTransactionInput { return v.Transaction }
+
+// GetEntries returns TxTemplateCreateInput.Entries, and is useful for accessing the field via an interface.
+func (v *TxTemplateCreateInput) GetEntries() []TxTemplateEntryInput { return v.Entries }
+
+// GetDescription returns TxTemplateCreateInput.Description, and is useful for accessing the field via an interface.
+func (v *TxTemplateCreateInput) GetDescription() *string { return v.Description }
+
+// GetMetadata returns TxTemplateCreateInput.Metadata, and is useful for accessing the field via an interface.
+func (v *TxTemplateCreateInput) GetMetadata() *json.RawMessage { return v.Metadata }
+
+type TxTemplateEntryInput struct {
+	EntryType   string  `json:"entryType"`
+	AccountId   string  `json:"accountId"`
+	Layer       string  `json:"layer"`
+	Direction   string  `json:"direction"`
+	Units       string  `json:"units"`
+	Currency    string  `json:"currency"`
+	Description *string `json:"description"`
+}
+
+// GetEntryType returns TxTemplateEntryInput.EntryType, and is useful for accessing the field via an interface.
+func (v *TxTemplateEntryInput) GetEntryType() string { return v.EntryType }
+
+// GetAccountId returns TxTemplateEntryInput.AccountId, and is useful for accessing the field via an interface.
+func (v *TxTemplateEntryInput) GetAccountId() string { return v.AccountId }
+
+// GetLayer returns TxTemplateEntryInput.Layer, and is useful for accessing the field via an interface.
+func (v *TxTemplateEntryInput) GetLayer() string { return v.Layer }
+
+// GetDirection returns TxTemplateEntryInput.Direction, and is useful for accessing the field via an interface.
+func (v *TxTemplateEntryInput) GetDirection() string { return v.Direction }
+
+// GetUnits returns TxTemplateEntryInput.Units, and is useful for accessing the field via an interface.
+func (v *TxTemplateEntryInput) GetUnits() string { return v.Units }
+
+// GetCurrency returns TxTemplateEntryInput.Currency, and is useful for accessing the field via an interface.
+func (v *TxTemplateEntryInput) GetCurrency() string { return v.Currency }
+
+// GetDescription returns TxTemplateEntryInput.Description, and is useful for accessing the field via an interface.
+func (v *TxTemplateEntryInput) GetDescription() *string { return v.Description }
+
+type TxTemplateTransactionInput struct {
+	Effective     string  `json:"effective"`
+	JournalId     string  `json:"journalId"`
+	CorrelationId *string `json:"correlationId"`
+	ExternalId    *string `json:"externalId"`
+	Description   *string `json:"description"`
+	Metadata      *string `json:"metadata"`
+}
+
+// GetEffective returns TxTemplateTransactionInput.Effective, and is useful for accessing the field via an interface.
+func (v *TxTemplateTransactionInput) GetEffective() string { return v.Effective }
+
+// GetJournalId returns TxTemplateTransactionInput.JournalId, and is useful for accessing the field via an interface.
+func (v *TxTemplateTransactionInput) GetJournalId() string { return v.JournalId }
+
+// GetCorrelationId returns TxTemplateTransactionInput.CorrelationId, and is useful for accessing the field via an interface.
+func (v *TxTemplateTransactionInput) GetCorrelationId() *string { return v.CorrelationId }
+
+// GetExternalId returns TxTemplateTransactionInput.ExternalId, and is useful for accessing the field via an interface.
+func (v *TxTemplateTransactionInput) GetExternalId() *string { return v.ExternalId }
+
+// GetDescription returns TxTemplateTransactionInput.Description, and is useful for accessing the field via an interface.
+func (v *TxTemplateTransactionInput) GetDescription() *string { return v.Description }
+
+// GetMetadata returns TxTemplateTransactionInput.Metadata, and is useful for accessing the field via an interface.
+func (v *TxTemplateTransactionInput) GetMetadata() *string { return v.Metadata }
+
+// __accountBalanceGetInput is used internally by genqlient
+type __accountBalanceGetInput struct {
+	Id        string `json:"id"`
+	JournalId string `json:"journalId"`
+	Currency  string `json:"currency"`
+}
+
+// GetId returns __accountBalanceGetInput.Id, and is useful for accessing the field via an interface.
+func (v *__accountBalanceGetInput) GetId() string { return v.Id }
+
+// GetJournalId returns __accountBalanceGetInput.JournalId, and is useful for accessing the field via an interface.
+func (v *__accountBalanceGetInput) GetJournalId() string { return v.JournalId }
+
+// GetCurrency returns __accountBalanceGetInput.Currency, and is useful for accessing the field via an interface.
+func (v *__accountBalanceGetInput) GetCurrency() string { return v.Currency }
+
 // __accountCreateInput is used internally by genqlient
 type __accountCreateInput struct {
 	Input AccountCreateInput `json:"input"`
@@ -245,6 +417,42 @@ type __accountGetInput struct {
 // GetId returns __accountGetInput.Id, and is useful for accessing the field via an interface.
 func (v *__accountGetInput) GetId() string { return v.Id }
 
+// __accountSetBalanceGetInput is used internally by genqlient
+type __accountSetBalanceGetInput struct {
+	Id       string `json:"id"`
+	Currency string `json:"currency"`
+}
+
+// GetId returns __accountSetBalanceGetInput.Id, and is useful for accessing the field via an interface.
+func (v *__accountSetBalanceGetInput) GetId() string { return v.Id }
+
+// GetCurrency returns __accountSetBalanceGetInput.Currency, and is useful for accessing the field via an interface.
+func (v *__accountSetBalanceGetInput) GetCurrency() string { return v.Currency }
+
+// __accountSetBalanceInRangeGetInput is used internally by genqlient
+type __accountSetBalanceInRangeGetInput struct {
+	Id        string  `json:"id"`
+	JournalId string  `json:"journalId"`
+	Currency  string  `json:"currency"`
+	From      string  `json:"from"`
+	Until     *string `json:"until"`
+}
+
+// GetId returns __accountSetBalanceInRangeGetInput.Id, and is useful for accessing the field via an interface.
+func (v *__accountSetBalanceInRangeGetInput) GetId() string { return v.Id }
+
+// GetJournalId returns __accountSetBalanceInRangeGetInput.JournalId, and is useful for accessing the field via an interface.
+func (v *__accountSetBalanceInRangeGetInput) GetJournalId() string { return v.JournalId }
+
+// GetCurrency returns __accountSetBalanceInRangeGetInput.Currency, and is useful for accessing the field via an interface.
+func (v *__accountSetBalanceInRangeGetInput) GetCurrency() string { return v.Currency }
+
+// GetFrom returns __accountSetBalanceInRangeGetInput.From, and is useful for accessing the field via an interface.
+func (v *__accountSetBalanceInRangeGetInput) GetFrom() string { return v.From }
+
+// GetUntil returns __accountSetBalanceInRangeGetInput.Until, and is useful for accessing the field via an interface.
+func (v *__accountSetBalanceInRangeGetInput) GetUntil() *string { return v.Until }
+
 // __accountSetCreateInput is used internally by genqlient
 type __accountSetCreateInput struct {
 	Input AccountSetCreateInput `json:"input"`
@@ -313,6 +521,22 @@ func (v *__accountSetMemberAccountSetRemoveInput) GetMemberAccountSetId() string
 	return v.MemberAccountSetId
 }
 
+// __accountSetMembersListInput is used internally by genqlient
+type __accountSetMembersListInput struct {
+	Id    string  `json:"id"`
+	First int     `json:"first"`
+	After *string `json:"after"`
+}
+
+// GetId returns __accountSetMembersListInput.Id, and is useful for accessing the field via an interface.
+func (v *__accountSetMembersListInput) GetId() string { return v.Id }
+
+// GetFirst returns __accountSetMembersListInput.First, and is useful for accessing the field via an interface.
+func (v *__accountSetMembersListInput) GetFirst() int { return v.First }
+
+// GetAfter returns __accountSetMembersListInput.After, and is useful for accessing the field via an interface.
+func (v *__accountSetMembersListInput) GetAfter() *string { return v.After }
+
 // __accountSetUpdateInput is used internally by genqlient
 type __accountSetUpdateInput struct {
 	Id    string                `json:"id"`
@@ -325,6 +549,38 @@ func (v *__accountSetUpdateInput) GetId() string { return v.Id }
 // GetInput returns __accountSetUpdateInput.Input, and is useful for accessing the field via an interface.
 func (v *__accountSetUpdateInput) GetInput() AccountSetUpdateInput { return v.Input }
 
+// __accountSetsOfAccountInput is used internally by genqlient
+type __accountSetsOfAccountInput struct {
+	Id    string  `json:"id"`
+	First int     `json:"first"`
+	After *string `json:"after"`
+}
+
+// GetId returns __accountSetsOfAccountInput.Id, and is useful for accessing the field via an interface.
+func (v *__accountSetsOfAccountInput) GetId() string { return v.Id }
+
+// GetFirst returns __accountSetsOfAccountInput.First, and is useful for accessing the field via an interface.
+func (v *__accountSetsOfAccountInput) GetFirst() int { return v.First }
+
+// GetAfter returns __accountSetsOfAccountInput.After, and is useful for accessing the field via an interface.
+func (v *__accountSetsOfAccountInput) GetAfter() *string { return v.After }
+
+// __accountSetsOfAccountSetInput is used internally by genqlient
+type __accountSetsOfAccountSetInput struct {
+	Id    string  `json:"id"`
+	First int     `json:"first"`
+	After *string `json:"after"`
+}
+
+// GetId returns __accountSetsOfAccountSetInput.Id, and is useful for accessing the field via an interface.
+func (v *__accountSetsOfAccountSetInput) GetId() string { return v.Id }
+
+// GetFirst returns __accountSetsOfAccountSetInput.First, and is useful for accessing the field via an interface.
+func (v *__accountSetsOfAccountSetInput) GetFirst() int { return v.First }
+
+// GetAfter returns __accountSetsOfAccountSetInput.After, and is useful for accessing the field via an interface.
+func (v *__accountSetsOfAccountSetInput) GetAfter() *string { return v.After }
+
 // __accountUpdateInput is used internally by genqlient
 type __accountUpdateInput struct {
 	Id    string             `json:"id"`
@@ -337,6 +593,34 @@ func (v *__accountUpdateInput) GetId() string { return v.Id }
 // GetInput returns __accountUpdateInput.Input, and is useful for accessing the field via an interface.
 func (v *__accountUpdateInput) GetInput() AccountUpdateInput { return v.Input }
 
+// __accountsListInput is used internally by genqlient
+type __accountsListInput struct {
+	First int     `json:"first"`
+	After *string `json:"after"`
+}
+
+// GetFirst returns __accountsListInput.First, and is useful for accessing the field via an interface.
+func (v *__accountsListInput) GetFirst() int { return v.First }
+
+// GetAfter returns __accountsListInput.After, and is useful for accessing the field via an interface.
+func (v *__accountsListInput) GetAfter() *string { return v.After }
+
+// __balanceGetInput is used internally by genqlient
+type __balanceGetInput struct {
+	JournalId string `json:"journalId"`
+	AccountId string `json:"accountId"`
+	Currency  string `json:"currency"`
+}
+
+// GetJournalId returns __balanceGetInput.JournalId, and is useful for accessing the field via an interface.
+func (v *__balanceGetInput) GetJournalId() string { return v.JournalId }
+
+// GetAccountId returns __balanceGetInput.AccountId, and is useful for accessing the field via an interface.
+func (v *__balanceGetInput) GetAccountId() string { return v.AccountId }
+
+// GetCurrency returns __balanceGetInput.Currency, and is useful for accessing the field via an interface.
+func (v *__balanceGetInput) GetCurrency() string { return v.Currency }
+
 // __bfxIntegrationCreateInput is used internally by genqlient
 type __bfxIntegrationCreateInput struct {
 	Input BfxIntegrationCreateInput `json:"input"`
@@ -369,6 +653,26 @@ type __bigQueryIntegrationGetInput struct {
 // GetId returns __bigQueryIntegrationGetInput.Id, and is useful for accessing the field via an interface.
 func (v *__bigQueryIntegrationGetInput) GetId() string { return v.Id }
 
+// __calaOutboxImportJobCreateInput is used internally by genqlient
+type __calaOutboxImportJobCreateInput struct {
+	Input CalaOutboxImportJobCreateInput `json:"input"`
+}
+
+// GetInput returns __calaOutboxImportJobCreateInput.Input, and is useful for accessing the field via an interface.
+func (v *__calaOutboxImportJobCreateInput) GetInput() CalaOutboxImportJobCreateInput { return v.Input }
+
+// __jobsListInput is used internally by genqlient
+type __jobsListInput struct {
+	First int     `json:"first"`
+	After *string `json:"after"`
+}
+
+// GetFirst returns __jobsListInput.First, and is useful for accessing the field via an interface.
+func (v *__jobsListInput) GetFirst() int { return v.First }
+
+// GetAfter returns __jobsListInput.After, and is useful for accessing the field via an interface.
+func (v *__jobsListInput) GetAfter() *string { return v.After }
+
 // __journalCreateInput is used internally by genqlient
 type __journalCreateInput struct {
 	Input JournalCreateInput `json:"input"`
@@ -397,6 +701,166 @@ func (v *__journalUpdateInput) GetId() string { return v.Id }
 // GetInput returns __journalUpdateInput.Input, and is useful for accessing the field via an interface.
 func (v *__journalUpdateInput) GetInput() JournalUpdateInput { return v.Input }
 
+// __transactionGetInput is used internally by genqlient
+type __transactionGetInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __transactionGetInput.Id, and is useful for accessing the field via an interface.
+func (v *__transactionGetInput) GetId() string { return v.Id }
+
+// __transactionPostInput is used internally by genqlient
+type __transactionPostInput struct {
+	Input TransactionInput `json:"input"`
+}
+
+// GetInput returns __transactionPostInput.Input, and is useful for accessing the field via an interface.
+func (v *__transactionPostInput) GetInput() TransactionInput { return v.Input }
+
+// __txTemplateCreateInput is used internally by genqlient
+type __txTemplateCreateInput struct {
+	Input TxTemplateCreateInput `json:"input"`
+}
+
+// GetInput returns __txTemplateCreateInput.Input, and is useful for accessing the field via an interface.
+func (v *__txTemplateCreateInput) GetInput() TxTemplateCreateInput { return v.Input }
+
+// __txTemplateGetByCodeInput is used internally by genqlient
+type __txTemplateGetByCodeInput struct {
+	Code string `json:"code"`
+}
+
+// GetCode returns __txTemplateGetByCodeInput.Code, and is useful for accessing the field via an interface.
+func (v *__txTemplateGetByCodeInput) GetCode() string { return v.Code }
+
+// __txTemplateGetInput is used internally by genqlient
+type __txTemplateGetInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __txTemplateGetInput.Id, and is useful for accessing the field via an interface.
+func (v *__txTemplateGetInput) GetId() string { return v.Id }
+
+// accountBalanceGetAccount includes the requested fields of the GraphQL type Account.
+type accountBalanceGetAccount struct {
+	AccountId string                           `json:"accountId"`
+	Balance   *accountBalanceGetAccountBalance `json:"balance"`
+}
+
+// GetAccountId returns accountBalanceGetAccount.AccountId, and is useful for accessing the field via an interface.
+func (v *accountBalanceGetAccount) GetAccountId() string { return v.AccountId }
+
+// GetBalance returns accountBalanceGetAccount.Balance, and is useful for accessing the field via an interface.
+func (v *accountBalanceGetAccount) GetBalance() *accountBalanceGetAccountBalance { return v.Balance }
+
+// accountBalanceGetAccountBalance includes the requested fields of the GraphQL type Balance.
+type accountBalanceGetAccountBalance struct {
+	balanceFields `json:"-"`
+}
+
+// GetCurrency returns accountBalanceGetAccountBalance.Currency, and is useful for accessing the field via an interface.
+func (v *accountBalanceGetAccountBalance) GetCurrency() string { return v.balanceFields.Currency }
+
+// GetSettled returns accountBalanceGetAccountBalance.Settled, and is useful for accessing the field via an interface.
+func (v *accountBalanceGetAccountBalance) GetSettled() balanceFieldsSettledBalanceAmount {
+	return v.balanceFields.Settled
+}
+
+// GetPending returns accountBalanceGetAccountBalance.Pending, and is useful for accessing the field via an interface.
+func (v *accountBalanceGetAccountBalance) GetPending() balanceFieldsPendingBalanceAmount {
+	return v.balanceFields.Pending
+}
+
+// GetEncumbrance returns accountBalanceGetAccountBalance.Encumbrance, and is useful for accessing the field via an interface.
+func (v *accountBalanceGetAccountBalance) GetEncumbrance() balanceFieldsEncumbranceBalanceAmount {
+	return v.balanceFields.Encumbrance
+}
+
+// GetAvailableSettled returns accountBalanceGetAccountBalance.AvailableSettled, and is useful for accessing the field via an interface.
+func (v *accountBalanceGetAccountBalance) GetAvailableSettled() balanceFieldsAvailableSettledBalanceAmount {
+	return v.balanceFields.AvailableSettled
+}
+
+// GetAvailablePending returns accountBalanceGetAccountBalance.AvailablePending, and is useful for accessing the field via an interface.
+func (v *accountBalanceGetAccountBalance) GetAvailablePending() balanceFieldsAvailablePendingBalanceAmount {
+	return v.balanceFields.AvailablePending
+}
+
+// GetAvailableEncumbrance returns accountBalanceGetAccountBalance.AvailableEncumbrance, and is useful for accessing the field via an interface.
+func (v *accountBalanceGetAccountBalance) GetAvailableEncumbrance() balanceFieldsAvailableEncumbranceBalanceAmount {
+	return v.balanceFields.AvailableEncumbrance
+}
+
+func (v *accountBalanceGetAccountBalance) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*accountBalanceGetAccountBalance
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.accountBalanceGetAccountBalance = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.balanceFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalaccountBalanceGetAccountBalance struct {
+	Currency string `json:"currency"`
+
+	Settled balanceFieldsSettledBalanceAmount `json:"settled"`
+
+	Pending balanceFieldsPendingBalanceAmount `json:"pending"`
+
+	Encumbrance balanceFieldsEncumbranceBalanceAmount `json:"encumbrance"`
+
+	AvailableSettled balanceFieldsAvailableSettledBalanceAmount `json:"availableSettled"`
+
+	AvailablePending balanceFieldsAvailablePendingBalanceAmount `json:"availablePending"`
+
+	AvailableEncumbrance balanceFieldsAvailableEncumbranceBalanceAmount `json:"availableEncumbrance"`
+}
+
+func (v *accountBalanceGetAccountBalance) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *accountBalanceGetAccountBalance) __premarshalJSON() (*__premarshalaccountBalanceGetAccountBalance, error) {
+	var retval __premarshalaccountBalanceGetAccountBalance
+
+	retval.Currency = v.balanceFields.Currency
+	retval.Settled = v.balanceFields.Settled
+	retval.Pending = v.balanceFields.Pending
+	retval.Encumbrance = v.balanceFields.Encumbrance
+	retval.AvailableSettled = v.balanceFields.AvailableSettled
+	retval.AvailablePending = v.balanceFields.AvailablePending
+	retval.AvailableEncumbrance = v.balanceFields.AvailableEncumbrance
+	return &retval, nil
+}
+
+// accountBalanceGetResponse is returned by accountBalanceGet on success.
+type accountBalanceGetResponse struct {
+	Account *accountBalanceGetAccount `json:"account"`
+}
+
+// GetAccount returns accountBalanceGetResponse.Account, and is useful for accessing the field via an interface.
+func (v *accountBalanceGetResponse) GetAccount() *accountBalanceGetAccount { return v.Account }
+
 // accountCreateAccountCreateAccountCreatePayload includes the requested fields of the GraphQL type AccountCreatePayload.
 type accountCreateAccountCreateAccountCreatePayload struct {
 	Account accountCreateAccountCreateAccountCreatePayloadAccount `json:"account"`
@@ -532,127 +996,395 @@ type accountGetResponse struct {
 // GetAccount returns accountGetResponse.Account, and is useful for accessing the field via an interface.
 func (v *accountGetResponse) GetAccount() *accountGetAccount { return v.Account }
 
-// accountSetCreateAccountSetCreateAccountSetCreatePayload includes the requested fields of the GraphQL type AccountSetCreatePayload.
-type accountSetCreateAccountSetCreateAccountSetCreatePayload struct {
-	AccountSet accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet `json:"accountSet"`
+// accountSetBalanceGetAccountSet includes the requested fields of the GraphQL type AccountSet.
+type accountSetBalanceGetAccountSet struct {
+	AccountSetId string                                 `json:"accountSetId"`
+	JournalId    string                                 `json:"journalId"`
+	Balance      *accountSetBalanceGetAccountSetBalance `json:"balance"`
 }
 
-// GetAccountSet returns accountSetCreateAccountSetCreateAccountSetCreatePayload.AccountSet, and is useful for accessing the field via an interface.
-func (v *accountSetCreateAccountSetCreateAccountSetCreatePayload) GetAccountSet() accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet {
-	return v.AccountSet
-}
+// GetAccountSetId returns accountSetBalanceGetAccountSet.AccountSetId, and is useful for accessing the field via an interface.
+func (v *accountSetBalanceGetAccountSet) GetAccountSetId() string { return v.AccountSetId }
 
-// accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet includes the requested fields of the GraphQL type AccountSet.
-type accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet struct {
-	AccountSetId      string           `json:"accountSetId"`
-	JournalId         string           `json:"journalId"`
-	Name              string           `json:"name"`
-	NormalBalanceType DebitOrCredit    `json:"normalBalanceType"`
-	Description       *string          `json:"description"`
-	Metadata          *json.RawMessage `json:"metadata"`
-}
+// GetJournalId returns accountSetBalanceGetAccountSet.JournalId, and is useful for accessing the field via an interface.
+func (v *accountSetBalanceGetAccountSet) GetJournalId() string { return v.JournalId }
 
-// GetAccountSetId returns accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet.AccountSetId, and is useful for accessing the field via an interface.
-func (v *accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet) GetAccountSetId() string {
-	return v.AccountSetId
+// GetBalance returns accountSetBalanceGetAccountSet.Balance, and is useful for accessing the field via an interface.
+func (v *accountSetBalanceGetAccountSet) GetBalance() *accountSetBalanceGetAccountSetBalance {
+	return v.Balance
 }
 
-// GetJournalId returns accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet.JournalId, and is useful for accessing the field via an interface.
-func (v *accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet) GetJournalId() string {
-	return v.JournalId
+// accountSetBalanceGetAccountSetBalance includes the requested fields of the GraphQL type Balance.
+type accountSetBalanceGetAccountSetBalance struct {
+	balanceFields `json:"-"`
 }
 
-// GetName returns accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet.Name, and is useful for accessing the field via an interface.
-func (v *accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet) GetName() string {
-	return v.Name
+// GetCurrency returns accountSetBalanceGetAccountSetBalance.Currency, and is useful for accessing the field via an interface.
+func (v *accountSetBalanceGetAccountSetBalance) GetCurrency() string { return v.balanceFields.Currency }
+
+// GetSettled returns accountSetBalanceGetAccountSetBalance.Settled, and is useful for accessing the field via an interface.
+func (v *accountSetBalanceGetAccountSetBalance) GetSettled() balanceFieldsSettledBalanceAmount {
+	return v.balanceFields.Settled
 }
 
-// GetNormalBalanceType returns accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet.NormalBalanceType, and is useful for accessing the field via an interface.
-func (v *accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet) GetNormalBalanceType() DebitOrCredit {
-	return v.NormalBalanceType
+// GetPending returns accountSetBalanceGetAccountSetBalance.Pending, and is useful for accessing the field via an interface.
+func (v *accountSetBalanceGetAccountSetBalance) GetPending() balanceFieldsPendingBalanceAmount {
+	return v.balanceFields.Pending
 }
 
-// GetDescription returns accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet.Description, and is useful for accessing the field via an interface.
-func (v *accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet) GetDescription() *string {
-	return v.Description
+// GetEncumbrance returns accountSetBalanceGetAccountSetBalance.Encumbrance, and is useful for accessing the field via an interface.
+func (v *accountSetBalanceGetAccountSetBalance) GetEncumbrance() balanceFieldsEncumbranceBalanceAmount {
+	return v.balanceFields.Encumbrance
 }
 
-// GetMetadata returns accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet.Metadata, and is useful for accessing the field via an interface.
-func (v *accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet) GetMetadata() *json.RawMessage {
-	return v.Metadata
+// GetAvailableSettled returns accountSetBalanceGetAccountSetBalance.AvailableSettled, and is useful for accessing the field via an interface.
+func (v *accountSetBalanceGetAccountSetBalance) GetAvailableSettled() balanceFieldsAvailableSettledBalanceAmount {
+	return v.balanceFields.AvailableSettled
 }
 
-// accountSetCreateResponse is returned by accountSetCreate on success.
-type accountSetCreateResponse struct {
-	AccountSetCreate accountSetCreateAccountSetCreateAccountSetCreatePayload `json:"accountSetCreate"`
+// GetAvailablePending returns accountSetBalanceGetAccountSetBalance.AvailablePending, and is useful for accessing the field via an interface.
+func (v *accountSetBalanceGetAccountSetBalance) GetAvailablePending() balanceFieldsAvailablePendingBalanceAmount {
+	return v.balanceFields.AvailablePending
 }
 
-// GetAccountSetCreate returns accountSetCreateResponse.AccountSetCreate, and is useful for accessing the field via an interface.
-func (v *accountSetCreateResponse) GetAccountSetCreate() accountSetCreateAccountSetCreateAccountSetCreatePayload {
-	return v.AccountSetCreate
+// GetAvailableEncumbrance returns accountSetBalanceGetAccountSetBalance.AvailableEncumbrance, and is useful for accessing the field via an interface.
+func (v *accountSetBalanceGetAccountSetBalance) GetAvailableEncumbrance() balanceFieldsAvailableEncumbranceBalanceAmount {
+	return v.balanceFields.AvailableEncumbrance
 }
 
-// accountSetGetAccountSet includes the requested fields of the GraphQL type AccountSet.
-type accountSetGetAccountSet struct {
-	AccountSetId      string                                          `json:"accountSetId"`
-	JournalId         string                                          `json:"journalId"`
-	Name              string                                          `json:"name"`
-	Description       *string                                         `json:"description"`
-	NormalBalanceType DebitOrCredit                                   `json:"normalBalanceType"`
-	Sets              accountSetGetAccountSetSetsAccountSetConnection `json:"sets"`
+func (v *accountSetBalanceGetAccountSetBalance) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*accountSetBalanceGetAccountSetBalance
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.accountSetBalanceGetAccountSetBalance = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.balanceFields)
+	if err != nil {
+		return err
+	}
+	return nil
 }
 
-// GetAccountSetId returns accountSetGetAccountSet.AccountSetId, and is useful for accessing the field via an interface.
-func (v *accountSetGetAccountSet) GetAccountSetId() string { return v.AccountSetId }
+type __premarshalaccountSetBalanceGetAccountSetBalance struct {
+	Currency string `json:"currency"`
 
-// GetJournalId returns accountSetGetAccountSet.JournalId, and is useful for accessing the field via an interface.
-func (v *accountSetGetAccountSet) GetJournalId() string { return v.JournalId }
+	Settled balanceFieldsSettledBalanceAmount `json:"settled"`
 
-// GetName returns accountSetGetAccountSet.Name, and is useful for accessing the field via an interface.
-func (v *accountSetGetAccountSet) GetName() string { return v.Name }
+	Pending balanceFieldsPendingBalanceAmount `json:"pending"`
 
-// GetDescription returns accountSetGetAccountSet.Description, and is useful for accessing the field via an interface.
-func (v *accountSetGetAccountSet) GetDescription() *string { return v.Description }
+	Encumbrance balanceFieldsEncumbranceBalanceAmount `json:"encumbrance"`
 
-// GetNormalBalanceType returns accountSetGetAccountSet.NormalBalanceType, and is useful for accessing the field via an interface.
-func (v *accountSetGetAccountSet) GetNormalBalanceType() DebitOrCredit { return v.NormalBalanceType }
+	AvailableSettled balanceFieldsAvailableSettledBalanceAmount `json:"availableSettled"`
 
-// GetSets returns accountSetGetAccountSet.Sets, and is useful for accessing the field via an interface.
-func (v *accountSetGetAccountSet) GetSets() accountSetGetAccountSetSetsAccountSetConnection {
-	return v.Sets
+	AvailablePending balanceFieldsAvailablePendingBalanceAmount `json:"availablePending"`
+
+	AvailableEncumbrance balanceFieldsAvailableEncumbranceBalanceAmount `json:"availableEncumbrance"`
 }
 
-// accountSetGetAccountSetSetsAccountSetConnection includes the requested fields of the GraphQL type AccountSetConnection.
-type accountSetGetAccountSetSetsAccountSetConnection struct {
-	// A list of nodes.
-	Nodes []accountSetGetAccountSetSetsAccountSetConnectionNodesAccountSet `json:"nodes"`
+func (v *accountSetBalanceGetAccountSetBalance) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// GetNodes returns accountSetGetAccountSetSetsAccountSetConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *accountSetGetAccountSetSetsAccountSetConnection) GetNodes() []accountSetGetAccountSetSetsAccountSetConnectionNodesAccountSet {
-	return v.Nodes
+func (v *accountSetBalanceGetAccountSetBalance) __premarshalJSON() (*__premarshalaccountSetBalanceGetAccountSetBalance, error) {
+	var retval __premarshalaccountSetBalanceGetAccountSetBalance
+
+	retval.Currency = v.balanceFields.Currency
+	retval.Settled = v.balanceFields.Settled
+	retval.Pending = v.balanceFields.Pending
+	retval.Encumbrance = v.balanceFields.Encumbrance
+	retval.AvailableSettled = v.balanceFields.AvailableSettled
+	retval.AvailablePending = v.balanceFields.AvailablePending
+	retval.AvailableEncumbrance = v.balanceFields.AvailableEncumbrance
+	return &retval, nil
 }
 
-// accountSetGetAccountSetSetsAccountSetConnectionNodesAccountSet includes the requested fields of the GraphQL type AccountSet.
-type accountSetGetAccountSetSetsAccountSetConnectionNodesAccountSet struct {
-	AccountSetId string `json:"accountSetId"`
+// accountSetBalanceGetResponse is returned by accountSetBalanceGet on success.
+type accountSetBalanceGetResponse struct {
+	AccountSet *accountSetBalanceGetAccountSet `json:"accountSet"`
 }
 
-// GetAccountSetId returns accountSetGetAccountSetSetsAccountSetConnectionNodesAccountSet.AccountSetId, and is useful for accessing the field via an interface.
-func (v *accountSetGetAccountSetSetsAccountSetConnectionNodesAccountSet) GetAccountSetId() string {
-	return v.AccountSetId
+// GetAccountSet returns accountSetBalanceGetResponse.AccountSet, and is useful for accessing the field via an interface.
+func (v *accountSetBalanceGetResponse) GetAccountSet() *accountSetBalanceGetAccountSet {
+	return v.AccountSet
 }
 
-// accountSetGetResponse is returned by accountSetGet on success.
-type accountSetGetResponse struct {
-	AccountSet *accountSetGetAccountSet `json:"accountSet"`
+// accountSetBalanceInRangeGetAccountSet includes the requested fields of the GraphQL type AccountSet.
+type accountSetBalanceInRangeGetAccountSet struct {
+	AccountSetId   string                                                            `json:"accountSetId"`
+	JournalId      string                                                            `json:"journalId"`
+	BalanceInRange *accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalance `json:"balanceInRange"`
 }
 
-// GetAccountSet returns accountSetGetResponse.AccountSet, and is useful for accessing the field via an interface.
-func (v *accountSetGetResponse) GetAccountSet() *accountSetGetAccountSet { return v.AccountSet }
+// GetAccountSetId returns accountSetBalanceInRangeGetAccountSet.AccountSetId, and is useful for accessing the field via an interface.
+func (v *accountSetBalanceInRangeGetAccountSet) GetAccountSetId() string { return v.AccountSetId }
 
-// accountSetMemberAccountCreateAddToAccountSetAddToAccountSetPayload includes the requested fields of the GraphQL type AddToAccountSetPayload.
-type accountSetMemberAccountCreateAddToAccountSetAddToAccountSetPayload struct {
+// GetJournalId returns accountSetBalanceInRangeGetAccountSet.JournalId, and is useful for accessing the field via an interface.
+func (v *accountSetBalanceInRangeGetAccountSet) GetJournalId() string { return v.JournalId }
+
+// GetBalanceInRange returns accountSetBalanceInRangeGetAccountSet.BalanceInRange, and is useful for accessing the field via an interface.
+func (v *accountSetBalanceInRangeGetAccountSet) GetBalanceInRange() *accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalance {
+	return v.BalanceInRange
+}
+
+// accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalance includes the requested fields of the GraphQL type RangedBalance.
+type accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalance struct {
+	Diff accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalanceDiffBalance `json:"diff"`
+}
+
+// GetDiff returns accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalance.Diff, and is useful for accessing the field via an interface.
+func (v *accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalance) GetDiff() accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalanceDiffBalance {
+	return v.Diff
+}
+
+// accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalanceDiffBalance includes the requested fields of the GraphQL type Balance.
+type accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalanceDiffBalance struct {
+	balanceFields `json:"-"`
+}
+
+// GetCurrency returns accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalanceDiffBalance.Currency, and is useful for accessing the field via an interface.
+func (v *accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalanceDiffBalance) GetCurrency() string {
+	return v.balanceFields.Currency
+}
+
+// GetSettled returns accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalanceDiffBalance.Settled, and is useful for accessing the field via an interface.
+func (v *accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalanceDiffBalance) GetSettled() balanceFieldsSettledBalanceAmount {
+	return v.balanceFields.Settled
+}
+
+// GetPending returns accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalanceDiffBalance.Pending, and is useful for accessing the field via an interface.
+func (v *accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalanceDiffBalance) GetPending() balanceFieldsPendingBalanceAmount {
+	return v.balanceFields.Pending
+}
+
+// GetEncumbrance returns accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalanceDiffBalance.Encumbrance, and is useful for accessing the field via an interface.
+func (v *accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalanceDiffBalance) GetEncumbrance() balanceFieldsEncumbranceBalanceAmount {
+	return v.balanceFields.Encumbrance
+}
+
+// GetAvailableSettled returns accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalanceDiffBalance.AvailableSettled, and is useful for accessing the field via an interface.
+func (v *accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalanceDiffBalance) GetAvailableSettled() balanceFieldsAvailableSettledBalanceAmount {
+	return v.balanceFields.AvailableSettled
+}
+
+// GetAvailablePending returns accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalanceDiffBalance.AvailablePending, and is useful for accessing the field via an interface.
+func (v *accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalanceDiffBalance) GetAvailablePending() balanceFieldsAvailablePendingBalanceAmount {
+	return v.balanceFields.AvailablePending
+}
+
+// GetAvailableEncumbrance returns accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalanceDiffBalance.AvailableEncumbrance, and is useful for accessing the field via an interface.
+func (v *accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalanceDiffBalance) GetAvailableEncumbrance() balanceFieldsAvailableEncumbranceBalanceAmount {
+	return v.balanceFields.AvailableEncumbrance
+}
+
+func (v *accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalanceDiffBalance) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalanceDiffBalance
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalanceDiffBalance = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.balanceFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalaccountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalanceDiffBalance struct {
+	Currency string `json:"currency"`
+
+	Settled balanceFieldsSettledBalanceAmount `json:"settled"`
+
+	Pending balanceFieldsPendingBalanceAmount `json:"pending"`
+
+	Encumbrance balanceFieldsEncumbranceBalanceAmount `json:"encumbrance"`
+
+	AvailableSettled balanceFieldsAvailableSettledBalanceAmount `json:"availableSettled"`
+
+	AvailablePending balanceFieldsAvailablePendingBalanceAmount `json:"availablePending"`
+
+	AvailableEncumbrance balanceFieldsAvailableEncumbranceBalanceAmount `json:"availableEncumbrance"`
+}
+
+func (v *accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalanceDiffBalance) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *accountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalanceDiffBalance) __premarshalJSON() (*__premarshalaccountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalanceDiffBalance, error) {
+	var retval __premarshalaccountSetBalanceInRangeGetAccountSetBalanceInRangeRangedBalanceDiffBalance
+
+	retval.Currency = v.balanceFields.Currency
+	retval.Settled = v.balanceFields.Settled
+	retval.Pending = v.balanceFields.Pending
+	retval.Encumbrance = v.balanceFields.Encumbrance
+	retval.AvailableSettled = v.balanceFields.AvailableSettled
+	retval.AvailablePending = v.balanceFields.AvailablePending
+	retval.AvailableEncumbrance = v.balanceFields.AvailableEncumbrance
+	return &retval, nil
+}
+
+// accountSetBalanceInRangeGetResponse is returned by accountSetBalanceInRangeGet on success.
+type accountSetBalanceInRangeGetResponse struct {
+	AccountSet *accountSetBalanceInRangeGetAccountSet `json:"accountSet"`
+}
+
+// GetAccountSet returns accountSetBalanceInRangeGetResponse.AccountSet, and is useful for accessing the field via an interface.
+func (v *accountSetBalanceInRangeGetResponse) GetAccountSet() *accountSetBalanceInRangeGetAccountSet {
+	return v.AccountSet
+}
+
+// accountSetCreateAccountSetCreateAccountSetCreatePayload includes the requested fields of the GraphQL type AccountSetCreatePayload.
+type accountSetCreateAccountSetCreateAccountSetCreatePayload struct {
+	AccountSet accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet `json:"accountSet"`
+}
+
+// GetAccountSet returns accountSetCreateAccountSetCreateAccountSetCreatePayload.AccountSet, and is useful for accessing the field via an interface.
+func (v *accountSetCreateAccountSetCreateAccountSetCreatePayload) GetAccountSet() accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet {
+	return v.AccountSet
+}
+
+// accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet includes the requested fields of the GraphQL type AccountSet.
+type accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet struct {
+	AccountSetId      string           `json:"accountSetId"`
+	JournalId         string           `json:"journalId"`
+	Name              string           `json:"name"`
+	NormalBalanceType DebitOrCredit    `json:"normalBalanceType"`
+	Description       *string          `json:"description"`
+	Metadata          *json.RawMessage `json:"metadata"`
+}
+
+// GetAccountSetId returns accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet.AccountSetId, and is useful for accessing the field via an interface.
+func (v *accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet) GetAccountSetId() string {
+	return v.AccountSetId
+}
+
+// GetJournalId returns accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet.JournalId, and is useful for accessing the field via an interface.
+func (v *accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet) GetJournalId() string {
+	return v.JournalId
+}
+
+// GetName returns accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet.Name, and is useful for accessing the field via an interface.
+func (v *accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet) GetName() string {
+	return v.Name
+}
+
+// GetNormalBalanceType returns accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet.NormalBalanceType, and is useful for accessing the field via an interface.
+func (v *accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet) GetNormalBalanceType() DebitOrCredit {
+	return v.NormalBalanceType
+}
+
+// GetDescription returns accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet.Description, and is useful for accessing the field via an interface.
+func (v *accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet) GetDescription() *string {
+	return v.Description
+}
+
+// GetMetadata returns accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet.Metadata, and is useful for accessing the field via an interface.
+func (v *accountSetCreateAccountSetCreateAccountSetCreatePayloadAccountSet) GetMetadata() *json.RawMessage {
+	return v.Metadata
+}
+
+// accountSetCreateResponse is returned by accountSetCreate on success.
+type accountSetCreateResponse struct {
+	AccountSetCreate accountSetCreateAccountSetCreateAccountSetCreatePayload `json:"accountSetCreate"`
+}
+
+// GetAccountSetCreate returns accountSetCreateResponse.AccountSetCreate, and is useful for accessing the field via an interface.
+func (v *accountSetCreateResponse) GetAccountSetCreate() accountSetCreateAccountSetCreateAccountSetCreatePayload {
+	return v.AccountSetCreate
+}
+
+// accountSetGetAccountSet includes the requested fields of the GraphQL type AccountSet.
+type accountSetGetAccountSet struct {
+	AccountSetId      string                                          `json:"accountSetId"`
+	JournalId         string                                          `json:"journalId"`
+	Name              string                                          `json:"name"`
+	Description       *string                                         `json:"description"`
+	NormalBalanceType DebitOrCredit                                   `json:"normalBalanceType"`
+	Sets              accountSetGetAccountSetSetsAccountSetConnection `json:"sets"`
+}
+
+// GetAccountSetId returns accountSetGetAccountSet.AccountSetId, and is useful for accessing the field via an interface.
+func (v *accountSetGetAccountSet) GetAccountSetId() string { return v.AccountSetId }
+
+// GetJournalId returns accountSetGetAccountSet.JournalId, and is useful for accessing the field via an interface.
+func (v *accountSetGetAccountSet) GetJournalId() string { return v.JournalId }
+
+// GetName returns accountSetGetAccountSet.Name, and is useful for accessing the field via an interface.
+func (v *accountSetGetAccountSet) GetName() string { return v.Name }
+
+// GetDescription returns accountSetGetAccountSet.Description, and is useful for accessing the field via an interface.
+func (v *accountSetGetAccountSet) GetDescription() *string { return v.Description }
+
+// GetNormalBalanceType returns accountSetGetAccountSet.NormalBalanceType, and is useful for accessing the field via an interface.
+func (v *accountSetGetAccountSet) GetNormalBalanceType() DebitOrCredit { return v.NormalBalanceType }
+
+// GetSets returns accountSetGetAccountSet.Sets, and is useful for accessing the field via an interface.
+func (v *accountSetGetAccountSet) GetSets() accountSetGetAccountSetSetsAccountSetConnection {
+	return v.Sets
+}
+
+// accountSetGetAccountSetSetsAccountSetConnection includes the requested fields of the GraphQL type AccountSetConnection.
+type accountSetGetAccountSetSetsAccountSetConnection struct {
+	// A list of nodes.
+	Nodes []accountSetGetAccountSetSetsAccountSetConnectionNodesAccountSet `json:"nodes"`
+}
+
+// GetNodes returns accountSetGetAccountSetSetsAccountSetConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *accountSetGetAccountSetSetsAccountSetConnection) GetNodes() []accountSetGetAccountSetSetsAccountSetConnectionNodesAccountSet {
+	return v.Nodes
+}
+
+// accountSetGetAccountSetSetsAccountSetConnectionNodesAccountSet includes the requested fields of the GraphQL type AccountSet.
+type accountSetGetAccountSetSetsAccountSetConnectionNodesAccountSet struct {
+	AccountSetId string `json:"accountSetId"`
+}
+
+// GetAccountSetId returns accountSetGetAccountSetSetsAccountSetConnectionNodesAccountSet.AccountSetId, and is useful for accessing the field via an interface.
+func (v *accountSetGetAccountSetSetsAccountSetConnectionNodesAccountSet) GetAccountSetId() string {
+	return v.AccountSetId
+}
+
+// accountSetGetResponse is returned by accountSetGet on success.
+type accountSetGetResponse struct {
+	AccountSet *accountSetGetAccountSet `json:"accountSet"`
+}
+
+// GetAccountSet returns accountSetGetResponse.AccountSet, and is useful for accessing the field via an interface.
+func (v *accountSetGetResponse) GetAccountSet() *accountSetGetAccountSet { return v.AccountSet }
+
+// accountSetMemberAccountCreateAddToAccountSetAddToAccountSetPayload includes the requested fields of the GraphQL type AddToAccountSetPayload.
+type accountSetMemberAccountCreateAddToAccountSetAddToAccountSetPayload struct {
 	AccountSet accountSetMemberAccountCreateAddToAccountSetAddToAccountSetPayloadAccountSet `json:"accountSet"`
 }
 
@@ -771,6 +1503,266 @@ func (v *accountSetMemberAccountSetRemoveResponse) GetRemoveFromAccountSet() acc
 	return v.RemoveFromAccountSet
 }
 
+// accountSetMembersListAccountSet includes the requested fields of the GraphQL type AccountSet.
+type accountSetMembersListAccountSet struct {
+	Members accountSetMembersListAccountSetMembersAccountSetMemberConnection `json:"members"`
+}
+
+// GetMembers returns accountSetMembersListAccountSet.Members, and is useful for accessing the field via an interface.
+func (v *accountSetMembersListAccountSet) GetMembers() accountSetMembersListAccountSetMembersAccountSetMemberConnection {
+	return v.Members
+}
+
+// accountSetMembersListAccountSetMembersAccountSetMemberConnection includes the requested fields of the GraphQL type AccountSetMemberConnection.
+type accountSetMembersListAccountSetMembersAccountSetMemberConnection struct {
+	// Information to aid in pagination.
+	PageInfo accountSetMembersListAccountSetMembersAccountSetMemberConnectionPageInfo `json:"pageInfo"`
+	// A list of nodes.
+	Nodes []accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSetMember `json:"-"`
+}
+
+// GetPageInfo returns accountSetMembersListAccountSetMembersAccountSetMemberConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *accountSetMembersListAccountSetMembersAccountSetMemberConnection) GetPageInfo() accountSetMembersListAccountSetMembersAccountSetMemberConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetNodes returns accountSetMembersListAccountSetMembersAccountSetMemberConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *accountSetMembersListAccountSetMembersAccountSetMemberConnection) GetNodes() []accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSetMember {
+	return v.Nodes
+}
+
+func (v *accountSetMembersListAccountSetMembersAccountSetMemberConnection) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*accountSetMembersListAccountSetMembersAccountSetMemberConnection
+		Nodes []json.RawMessage `json:"nodes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.accountSetMembersListAccountSetMembersAccountSetMemberConnection = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Nodes
+		src := firstPass.Nodes
+		*dst = make(
+			[]accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSetMember,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalaccountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSetMember(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"unable to unmarshal accountSetMembersListAccountSetMembersAccountSetMemberConnection.Nodes: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalaccountSetMembersListAccountSetMembersAccountSetMemberConnection struct {
+	PageInfo accountSetMembersListAccountSetMembersAccountSetMemberConnectionPageInfo `json:"pageInfo"`
+
+	Nodes []json.RawMessage `json:"nodes"`
+}
+
+func (v *accountSetMembersListAccountSetMembersAccountSetMemberConnection) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *accountSetMembersListAccountSetMembersAccountSetMemberConnection) __premarshalJSON() (*__premarshalaccountSetMembersListAccountSetMembersAccountSetMemberConnection, error) {
+	var retval __premarshalaccountSetMembersListAccountSetMembersAccountSetMemberConnection
+
+	retval.PageInfo = v.PageInfo
+	{
+
+		dst := &retval.Nodes
+		src := v.Nodes
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalaccountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSetMember(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal accountSetMembersListAccountSetMembersAccountSetMemberConnection.Nodes: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccount includes the requested fields of the GraphQL type Account.
+type accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccount struct {
+	Typename  *string `json:"__typename"`
+	AccountId string  `json:"accountId"`
+	Code      string  `json:"code"`
+	Name      string  `json:"name"`
+}
+
+// GetTypename returns accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccount.Typename, and is useful for accessing the field via an interface.
+func (v *accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccount) GetTypename() *string {
+	return v.Typename
+}
+
+// GetAccountId returns accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccount.AccountId, and is useful for accessing the field via an interface.
+func (v *accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccount) GetAccountId() string {
+	return v.AccountId
+}
+
+// GetCode returns accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccount.Code, and is useful for accessing the field via an interface.
+func (v *accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccount) GetCode() string {
+	return v.Code
+}
+
+// GetName returns accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccount.Name, and is useful for accessing the field via an interface.
+func (v *accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccount) GetName() string {
+	return v.Name
+}
+
+// accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSet includes the requested fields of the GraphQL type AccountSet.
+type accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSet struct {
+	Typename     *string `json:"__typename"`
+	AccountSetId string  `json:"accountSetId"`
+	Name         string  `json:"name"`
+}
+
+// GetTypename returns accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSet.Typename, and is useful for accessing the field via an interface.
+func (v *accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSet) GetTypename() *string {
+	return v.Typename
+}
+
+// GetAccountSetId returns accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSet.AccountSetId, and is useful for accessing the field via an interface.
+func (v *accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSet) GetAccountSetId() string {
+	return v.AccountSetId
+}
+
+// GetName returns accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSet.Name, and is useful for accessing the field via an interface.
+func (v *accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSet) GetName() string {
+	return v.Name
+}
+
+// accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSetMember includes the requested fields of the GraphQL interface AccountSetMember.
+//
+// accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSetMember is implemented by the following types:
+// accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccount
+// accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSet
+type accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSetMember interface {
+	implementsGraphQLInterfaceaccountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSetMember()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+}
+
+func (v *accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccount) implementsGraphQLInterfaceaccountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSetMember() {
+}
+func (v *accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSet) implementsGraphQLInterfaceaccountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSetMember() {
+}
+
+func __unmarshalaccountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSetMember(b []byte, v *accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSetMember) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Account":
+		*v = new(accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccount)
+		return json.Unmarshal(b, *v)
+	case "AccountSet":
+		*v = new(accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSet)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing AccountSetMember.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSetMember: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalaccountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSetMember(v *accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSetMember) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccount:
+		typename = "Account"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccount
+		}{typename, v}
+		return json.Marshal(result)
+	case *accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSet:
+		typename = "AccountSet"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSet
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for accountSetMembersListAccountSetMembersAccountSetMemberConnectionNodesAccountSetMember: "%T"`, v)
+	}
+}
+
+// accountSetMembersListAccountSetMembersAccountSetMemberConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection
+type accountSetMembersListAccountSetMembersAccountSetMemberConnectionPageInfo struct {
+	// When paginating forwards, are there more items?
+	HasNextPage bool `json:"hasNextPage"`
+	// When paginating forwards, the cursor to continue.
+	EndCursor *string `json:"endCursor"`
+}
+
+// GetHasNextPage returns accountSetMembersListAccountSetMembersAccountSetMemberConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *accountSetMembersListAccountSetMembersAccountSetMemberConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns accountSetMembersListAccountSetMembersAccountSetMemberConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *accountSetMembersListAccountSetMembersAccountSetMemberConnectionPageInfo) GetEndCursor() *string {
+	return v.EndCursor
+}
+
+// accountSetMembersListResponse is returned by accountSetMembersList on success.
+type accountSetMembersListResponse struct {
+	AccountSet *accountSetMembersListAccountSet `json:"accountSet"`
+}
+
+// GetAccountSet returns accountSetMembersListResponse.AccountSet, and is useful for accessing the field via an interface.
+func (v *accountSetMembersListResponse) GetAccountSet() *accountSetMembersListAccountSet {
+	return v.AccountSet
+}
+
 // accountSetUpdateAccountSetUpdateAccountSetUpdatePayload includes the requested fields of the GraphQL type AccountSetUpdatePayload.
 type accountSetUpdateAccountSetUpdateAccountSetUpdatePayload struct {
 	AccountSet accountSetUpdateAccountSetUpdateAccountSetUpdatePayloadAccountSet `json:"accountSet"`
@@ -825,7 +1817,143 @@ func (v *accountSetUpdateResponse) GetAccountSetUpdate() accountSetUpdateAccount
 	return v.AccountSetUpdate
 }
 
-// accountUpdateAccountUpdateAccountUpdatePayload includes the requested fields of the GraphQL type AccountUpdatePayload.
+// accountSetsOfAccountAccount includes the requested fields of the GraphQL type Account.
+type accountSetsOfAccountAccount struct {
+	Sets accountSetsOfAccountAccountSetsAccountSetConnection `json:"sets"`
+}
+
+// GetSets returns accountSetsOfAccountAccount.Sets, and is useful for accessing the field via an interface.
+func (v *accountSetsOfAccountAccount) GetSets() accountSetsOfAccountAccountSetsAccountSetConnection {
+	return v.Sets
+}
+
+// accountSetsOfAccountAccountSetsAccountSetConnection includes the requested fields of the GraphQL type AccountSetConnection.
+type accountSetsOfAccountAccountSetsAccountSetConnection struct {
+	// Information to aid in pagination.
+	PageInfo accountSetsOfAccountAccountSetsAccountSetConnectionPageInfo `json:"pageInfo"`
+	// A list of nodes.
+	Nodes []accountSetsOfAccountAccountSetsAccountSetConnectionNodesAccountSet `json:"nodes"`
+}
+
+// GetPageInfo returns accountSetsOfAccountAccountSetsAccountSetConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *accountSetsOfAccountAccountSetsAccountSetConnection) GetPageInfo() accountSetsOfAccountAccountSetsAccountSetConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetNodes returns accountSetsOfAccountAccountSetsAccountSetConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *accountSetsOfAccountAccountSetsAccountSetConnection) GetNodes() []accountSetsOfAccountAccountSetsAccountSetConnectionNodesAccountSet {
+	return v.Nodes
+}
+
+// accountSetsOfAccountAccountSetsAccountSetConnectionNodesAccountSet includes the requested fields of the GraphQL type AccountSet.
+type accountSetsOfAccountAccountSetsAccountSetConnectionNodesAccountSet struct {
+	AccountSetId string `json:"accountSetId"`
+}
+
+// GetAccountSetId returns accountSetsOfAccountAccountSetsAccountSetConnectionNodesAccountSet.AccountSetId, and is useful for accessing the field via an interface.
+func (v *accountSetsOfAccountAccountSetsAccountSetConnectionNodesAccountSet) GetAccountSetId() string {
+	return v.AccountSetId
+}
+
+// accountSetsOfAccountAccountSetsAccountSetConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection
+type accountSetsOfAccountAccountSetsAccountSetConnectionPageInfo struct {
+	// When paginating forwards, are there more items?
+	HasNextPage bool `json:"hasNextPage"`
+	// When paginating forwards, the cursor to continue.
+	EndCursor *string `json:"endCursor"`
+}
+
+// GetHasNextPage returns accountSetsOfAccountAccountSetsAccountSetConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *accountSetsOfAccountAccountSetsAccountSetConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns accountSetsOfAccountAccountSetsAccountSetConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *accountSetsOfAccountAccountSetsAccountSetConnectionPageInfo) GetEndCursor() *string {
+	return v.EndCursor
+}
+
+// accountSetsOfAccountResponse is returned by accountSetsOfAccount on success.
+type accountSetsOfAccountResponse struct {
+	Account *accountSetsOfAccountAccount `json:"account"`
+}
+
+// GetAccount returns accountSetsOfAccountResponse.Account, and is useful for accessing the field via an interface.
+func (v *accountSetsOfAccountResponse) GetAccount() *accountSetsOfAccountAccount { return v.Account }
+
+// accountSetsOfAccountSetAccountSet includes the requested fields of the GraphQL type AccountSet.
+type accountSetsOfAccountSetAccountSet struct {
+	Sets accountSetsOfAccountSetAccountSetSetsAccountSetConnection `json:"sets"`
+}
+
+// GetSets returns accountSetsOfAccountSetAccountSet.Sets, and is useful for accessing the field via an interface.
+func (v *accountSetsOfAccountSetAccountSet) GetSets() accountSetsOfAccountSetAccountSetSetsAccountSetConnection {
+	return v.Sets
+}
+
+// accountSetsOfAccountSetAccountSetSetsAccountSetConnection includes the requested fields of the GraphQL type AccountSetConnection.
+type accountSetsOfAccountSetAccountSetSetsAccountSetConnection struct {
+	// Information to aid in pagination.
+	PageInfo accountSetsOfAccountSetAccountSetSetsAccountSetConnectionPageInfo `json:"pageInfo"`
+	// A list of nodes.
+	Nodes []accountSetsOfAccountSetAccountSetSetsAccountSetConnectionNodesAccountSet `json:"nodes"`
+}
+
+// GetPageInfo returns accountSetsOfAccountSetAccountSetSetsAccountSetConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *accountSetsOfAccountSetAccountSetSetsAccountSetConnection) GetPageInfo() accountSetsOfAccountSetAccountSetSetsAccountSetConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetNodes returns accountSetsOfAccountSetAccountSetSetsAccountSetConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *accountSetsOfAccountSetAccountSetSetsAccountSetConnection) GetNodes() []accountSetsOfAccountSetAccountSetSetsAccountSetConnectionNodesAccountSet {
+	return v.Nodes
+}
+
+// accountSetsOfAccountSetAccountSetSetsAccountSetConnectionNodesAccountSet includes the requested fields of the GraphQL type AccountSet.
+type accountSetsOfAccountSetAccountSetSetsAccountSetConnectionNodesAccountSet struct {
+	AccountSetId string `json:"accountSetId"`
+}
+
+// GetAccountSetId returns accountSetsOfAccountSetAccountSetSetsAccountSetConnectionNodesAccountSet.AccountSetId, and is useful for accessing the field via an interface.
+func (v *accountSetsOfAccountSetAccountSetSetsAccountSetConnectionNodesAccountSet) GetAccountSetId() string {
+	return v.AccountSetId
+}
+
+// accountSetsOfAccountSetAccountSetSetsAccountSetConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection
+type accountSetsOfAccountSetAccountSetSetsAccountSetConnectionPageInfo struct {
+	// When paginating forwards, are there more items?
+	HasNextPage bool `json:"hasNextPage"`
+	// When paginating forwards, the cursor to continue.
+	EndCursor *string `json:"endCursor"`
+}
+
+// GetHasNextPage returns accountSetsOfAccountSetAccountSetSetsAccountSetConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *accountSetsOfAccountSetAccountSetSetsAccountSetConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns accountSetsOfAccountSetAccountSetSetsAccountSetConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *accountSetsOfAccountSetAccountSetSetsAccountSetConnectionPageInfo) GetEndCursor() *string {
+	return v.EndCursor
+}
+
+// accountSetsOfAccountSetResponse is returned by accountSetsOfAccountSet on success.
+type accountSetsOfAccountSetResponse struct {
+	AccountSet *accountSetsOfAccountSetAccountSet `json:"accountSet"`
+}
+
+// GetAccountSet returns accountSetsOfAccountSetResponse.AccountSet, and is useful for accessing the field via an interface.
+func (v *accountSetsOfAccountSetResponse) GetAccountSet() *accountSetsOfAccountSetAccountSet {
+	return v.AccountSet
+}
+
+// accountUpdateAccountUpdateAccountUpdatePayload includes the requested fields of the GraphQL type AccountUpdatePayload.
 type accountUpdateAccountUpdateAccountUpdatePayload struct {
 	Account accountUpdateAccountUpdateAccountUpdatePayloadAccount `json:"account"`
 }
@@ -891,236 +2019,1046 @@ func (v *accountUpdateResponse) GetAccountUpdate() accountUpdateAccountUpdateAcc
 	return v.AccountUpdate
 }
 
-// bfxIntegrationCreateBitfinexBitfinexMutation includes the requested fields of the GraphQL type BitfinexMutation.
-type bfxIntegrationCreateBitfinexBitfinexMutation struct {
-	IntegrationCreate bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayload `json:"integrationCreate"`
+// accountsListAccountsAccountConnection includes the requested fields of the GraphQL type AccountConnection.
+type accountsListAccountsAccountConnection struct {
+	// Information to aid in pagination.
+	PageInfo accountsListAccountsAccountConnectionPageInfo `json:"pageInfo"`
+	// A list of nodes.
+	Nodes []accountsListAccountsAccountConnectionNodesAccount `json:"nodes"`
 }
 
-// GetIntegrationCreate returns bfxIntegrationCreateBitfinexBitfinexMutation.IntegrationCreate, and is useful for accessing the field via an interface.
-func (v *bfxIntegrationCreateBitfinexBitfinexMutation) GetIntegrationCreate() bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayload {
-	return v.IntegrationCreate
+// GetPageInfo returns accountsListAccountsAccountConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *accountsListAccountsAccountConnection) GetPageInfo() accountsListAccountsAccountConnectionPageInfo {
+	return v.PageInfo
 }
 
-// bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayload includes the requested fields of the GraphQL type BfxIntegrationCreatePayload.
-type bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayload struct {
-	Integration bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayloadIntegrationBfxIntegration `json:"integration"`
+// GetNodes returns accountsListAccountsAccountConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *accountsListAccountsAccountConnection) GetNodes() []accountsListAccountsAccountConnectionNodesAccount {
+	return v.Nodes
 }
 
-// GetIntegration returns bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayload.Integration, and is useful for accessing the field via an interface.
-func (v *bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayload) GetIntegration() bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayloadIntegrationBfxIntegration {
-	return v.Integration
+// accountsListAccountsAccountConnectionNodesAccount includes the requested fields of the GraphQL type Account.
+type accountsListAccountsAccountConnectionNodesAccount struct {
+	AccountId         string           `json:"accountId"`
+	Code              string           `json:"code"`
+	Name              string           `json:"name"`
+	NormalBalanceType DebitOrCredit    `json:"normalBalanceType"`
+	Status            Status           `json:"status"`
+	ExternalId        *string          `json:"externalId"`
+	Description       *string          `json:"description"`
+	Metadata          *json.RawMessage `json:"metadata"`
+	CreatedAt         string           `json:"createdAt"`
+	ModifiedAt        string           `json:"modifiedAt"`
 }
 
-// bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayloadIntegrationBfxIntegration includes the requested fields of the GraphQL type BfxIntegration.
-type bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayloadIntegrationBfxIntegration struct {
-	IntegrationId    string  `json:"integrationId"`
-	Name             string  `json:"name"`
-	Description      *string `json:"description"`
-	OmnibusAccountId string  `json:"omnibusAccountId"`
-}
+// GetAccountId returns accountsListAccountsAccountConnectionNodesAccount.AccountId, and is useful for accessing the field via an interface.
+func (v *accountsListAccountsAccountConnectionNodesAccount) GetAccountId() string { return v.AccountId }
 
-// GetIntegrationId returns bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayloadIntegrationBfxIntegration.IntegrationId, and is useful for accessing the field via an interface.
-func (v *bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayloadIntegrationBfxIntegration) GetIntegrationId() string {
-	return v.IntegrationId
+// GetCode returns accountsListAccountsAccountConnectionNodesAccount.Code, and is useful for accessing the field via an interface.
+func (v *accountsListAccountsAccountConnectionNodesAccount) GetCode() string { return v.Code }
+
+// GetName returns accountsListAccountsAccountConnectionNodesAccount.Name, and is useful for accessing the field via an interface.
+func (v *accountsListAccountsAccountConnectionNodesAccount) GetName() string { return v.Name }
+
+// GetNormalBalanceType returns accountsListAccountsAccountConnectionNodesAccount.NormalBalanceType, and is useful for accessing the field via an interface.
+func (v *accountsListAccountsAccountConnectionNodesAccount) GetNormalBalanceType() DebitOrCredit {
+	return v.NormalBalanceType
 }
 
-// GetName returns bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayloadIntegrationBfxIntegration.Name, and is useful for accessing the field via an interface.
-func (v *bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayloadIntegrationBfxIntegration) GetName() string {
-	return v.Name
+// GetStatus returns accountsListAccountsAccountConnectionNodesAccount.Status, and is useful for accessing the field via an interface.
+func (v *accountsListAccountsAccountConnectionNodesAccount) GetStatus() Status { return v.Status }
+
+// GetExternalId returns accountsListAccountsAccountConnectionNodesAccount.ExternalId, and is useful for accessing the field via an interface.
+func (v *accountsListAccountsAccountConnectionNodesAccount) GetExternalId() *string {
+	return v.ExternalId
 }
 
-// GetDescription returns bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayloadIntegrationBfxIntegration.Description, and is useful for accessing the field via an interface.
-func (v *bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayloadIntegrationBfxIntegration) GetDescription() *string {
+// GetDescription returns accountsListAccountsAccountConnectionNodesAccount.Description, and is useful for accessing the field via an interface.
+func (v *accountsListAccountsAccountConnectionNodesAccount) GetDescription() *string {
 	return v.Description
 }
 
-// GetOmnibusAccountId returns bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayloadIntegrationBfxIntegration.OmnibusAccountId, and is useful for accessing the field via an interface.
-func (v *bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayloadIntegrationBfxIntegration) GetOmnibusAccountId() string {
-	return v.OmnibusAccountId
+// GetMetadata returns accountsListAccountsAccountConnectionNodesAccount.Metadata, and is useful for accessing the field via an interface.
+func (v *accountsListAccountsAccountConnectionNodesAccount) GetMetadata() *json.RawMessage {
+	return v.Metadata
 }
 
-// bfxIntegrationCreateResponse is returned by bfxIntegrationCreate on success.
-type bfxIntegrationCreateResponse struct {
-	Bitfinex bfxIntegrationCreateBitfinexBitfinexMutation `json:"bitfinex"`
-}
+// GetCreatedAt returns accountsListAccountsAccountConnectionNodesAccount.CreatedAt, and is useful for accessing the field via an interface.
+func (v *accountsListAccountsAccountConnectionNodesAccount) GetCreatedAt() string { return v.CreatedAt }
 
-// GetBitfinex returns bfxIntegrationCreateResponse.Bitfinex, and is useful for accessing the field via an interface.
-func (v *bfxIntegrationCreateResponse) GetBitfinex() bfxIntegrationCreateBitfinexBitfinexMutation {
-	return v.Bitfinex
+// GetModifiedAt returns accountsListAccountsAccountConnectionNodesAccount.ModifiedAt, and is useful for accessing the field via an interface.
+func (v *accountsListAccountsAccountConnectionNodesAccount) GetModifiedAt() string {
+	return v.ModifiedAt
 }
 
-// bfxIntegrationGetBitfinexBitfinexQuery includes the requested fields of the GraphQL type BitfinexQuery.
-type bfxIntegrationGetBitfinexBitfinexQuery struct {
-	Integration *bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration `json:"integration"`
+// accountsListAccountsAccountConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection
+type accountsListAccountsAccountConnectionPageInfo struct {
+	// When paginating forwards, are there more items?
+	HasNextPage bool `json:"hasNextPage"`
+	// When paginating forwards, the cursor to continue.
+	EndCursor *string `json:"endCursor"`
 }
 
-// GetIntegration returns bfxIntegrationGetBitfinexBitfinexQuery.Integration, and is useful for accessing the field via an interface.
-func (v *bfxIntegrationGetBitfinexBitfinexQuery) GetIntegration() *bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration {
-	return v.Integration
-}
+// GetHasNextPage returns accountsListAccountsAccountConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *accountsListAccountsAccountConnectionPageInfo) GetHasNextPage() bool { return v.HasNextPage }
 
-// bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration includes the requested fields of the GraphQL type BfxIntegration.
-type bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration struct {
-	IntegrationId    string  `json:"integrationId"`
-	Name             string  `json:"name"`
-	Description      *string `json:"description"`
-	OmnibusAccountId string  `json:"omnibusAccountId"`
+// GetEndCursor returns accountsListAccountsAccountConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *accountsListAccountsAccountConnectionPageInfo) GetEndCursor() *string { return v.EndCursor }
+
+// accountsListResponse is returned by accountsList on success.
+type accountsListResponse struct {
+	Accounts accountsListAccountsAccountConnection `json:"accounts"`
 }
 
-// GetIntegrationId returns bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration.IntegrationId, and is useful for accessing the field via an interface.
-func (v *bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration) GetIntegrationId() string {
-	return v.IntegrationId
+// GetAccounts returns accountsListResponse.Accounts, and is useful for accessing the field via an interface.
+func (v *accountsListResponse) GetAccounts() accountsListAccountsAccountConnection { return v.Accounts }
+
+// balanceAmountFields includes the GraphQL fields of BalanceAmount requested by the fragment balanceAmountFields.
+type balanceAmountFields struct {
+	DrBalance     balanceAmountFieldsDrBalanceMoney     `json:"drBalance"`
+	CrBalance     balanceAmountFieldsCrBalanceMoney     `json:"crBalance"`
+	NormalBalance balanceAmountFieldsNormalBalanceMoney `json:"normalBalance"`
 }
 
-// GetName returns bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration.Name, and is useful for accessing the field via an interface.
-func (v *bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration) GetName() string {
-	return v.Name
+// GetDrBalance returns balanceAmountFields.DrBalance, and is useful for accessing the field via an interface.
+func (v *balanceAmountFields) GetDrBalance() balanceAmountFieldsDrBalanceMoney { return v.DrBalance }
+
+// GetCrBalance returns balanceAmountFields.CrBalance, and is useful for accessing the field via an interface.
+func (v *balanceAmountFields) GetCrBalance() balanceAmountFieldsCrBalanceMoney { return v.CrBalance }
+
+// GetNormalBalance returns balanceAmountFields.NormalBalance, and is useful for accessing the field via an interface.
+func (v *balanceAmountFields) GetNormalBalance() balanceAmountFieldsNormalBalanceMoney {
+	return v.NormalBalance
 }
 
-// GetDescription returns bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration.Description, and is useful for accessing the field via an interface.
-func (v *bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration) GetDescription() *string {
-	return v.Description
+// balanceAmountFieldsCrBalanceMoney includes the requested fields of the GraphQL type Money.
+type balanceAmountFieldsCrBalanceMoney struct {
+	Units string `json:"units"`
 }
 
-// GetOmnibusAccountId returns bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration.OmnibusAccountId, and is useful for accessing the field via an interface.
-func (v *bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration) GetOmnibusAccountId() string {
-	return v.OmnibusAccountId
+// GetUnits returns balanceAmountFieldsCrBalanceMoney.Units, and is useful for accessing the field via an interface.
+func (v *balanceAmountFieldsCrBalanceMoney) GetUnits() string { return v.Units }
+
+// balanceAmountFieldsDrBalanceMoney includes the requested fields of the GraphQL type Money.
+type balanceAmountFieldsDrBalanceMoney struct {
+	Units string `json:"units"`
 }
 
-// bfxIntegrationGetResponse is returned by bfxIntegrationGet on success.
-type bfxIntegrationGetResponse struct {
-	Bitfinex bfxIntegrationGetBitfinexBitfinexQuery `json:"bitfinex"`
+// GetUnits returns balanceAmountFieldsDrBalanceMoney.Units, and is useful for accessing the field via an interface.
+func (v *balanceAmountFieldsDrBalanceMoney) GetUnits() string { return v.Units }
+
+// balanceAmountFieldsNormalBalanceMoney includes the requested fields of the GraphQL type Money.
+type balanceAmountFieldsNormalBalanceMoney struct {
+	Units string `json:"units"`
 }
 
-// GetBitfinex returns bfxIntegrationGetResponse.Bitfinex, and is useful for accessing the field via an interface.
-func (v *bfxIntegrationGetResponse) GetBitfinex() bfxIntegrationGetBitfinexBitfinexQuery {
-	return v.Bitfinex
+// GetUnits returns balanceAmountFieldsNormalBalanceMoney.Units, and is useful for accessing the field via an interface.
+func (v *balanceAmountFieldsNormalBalanceMoney) GetUnits() string { return v.Units }
+
+// balanceFields includes the GraphQL fields of Balance requested by the fragment balanceFields.
+type balanceFields struct {
+	Currency             string                                         `json:"currency"`
+	Settled              balanceFieldsSettledBalanceAmount              `json:"settled"`
+	Pending              balanceFieldsPendingBalanceAmount              `json:"pending"`
+	Encumbrance          balanceFieldsEncumbranceBalanceAmount          `json:"encumbrance"`
+	AvailableSettled     balanceFieldsAvailableSettledBalanceAmount     `json:"availableSettled"`
+	AvailablePending     balanceFieldsAvailablePendingBalanceAmount     `json:"availablePending"`
+	AvailableEncumbrance balanceFieldsAvailableEncumbranceBalanceAmount `json:"availableEncumbrance"`
 }
 
-// bigQueryIntegrationCreateBigQueryBigQueryMutation includes the requested fields of the GraphQL type BigQueryMutation.
-type bigQueryIntegrationCreateBigQueryBigQueryMutation struct {
-	IntegrationCreate bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayload `json:"integrationCreate"`
+// GetCurrency returns balanceFields.Currency, and is useful for accessing the field via an interface.
+func (v *balanceFields) GetCurrency() string { return v.Currency }
+
+// GetSettled returns balanceFields.Settled, and is useful for accessing the field via an interface.
+func (v *balanceFields) GetSettled() balanceFieldsSettledBalanceAmount { return v.Settled }
+
+// GetPending returns balanceFields.Pending, and is useful for accessing the field via an interface.
+func (v *balanceFields) GetPending() balanceFieldsPendingBalanceAmount { return v.Pending }
+
+// GetEncumbrance returns balanceFields.Encumbrance, and is useful for accessing the field via an interface.
+func (v *balanceFields) GetEncumbrance() balanceFieldsEncumbranceBalanceAmount { return v.Encumbrance }
+
+// GetAvailableSettled returns balanceFields.AvailableSettled, and is useful for accessing the field via an interface.
+func (v *balanceFields) GetAvailableSettled() balanceFieldsAvailableSettledBalanceAmount {
+	return v.AvailableSettled
 }
 
-// GetIntegrationCreate returns bigQueryIntegrationCreateBigQueryBigQueryMutation.IntegrationCreate, and is useful for accessing the field via an interface.
-func (v *bigQueryIntegrationCreateBigQueryBigQueryMutation) GetIntegrationCreate() bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayload {
-	return v.IntegrationCreate
+// GetAvailablePending returns balanceFields.AvailablePending, and is useful for accessing the field via an interface.
+func (v *balanceFields) GetAvailablePending() balanceFieldsAvailablePendingBalanceAmount {
+	return v.AvailablePending
 }
 
-// bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayload includes the requested fields of the GraphQL type BigQueryIntegrationCreatePayload.
-type bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayload struct {
-	Integration bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration `json:"integration"`
+// GetAvailableEncumbrance returns balanceFields.AvailableEncumbrance, and is useful for accessing the field via an interface.
+func (v *balanceFields) GetAvailableEncumbrance() balanceFieldsAvailableEncumbranceBalanceAmount {
+	return v.AvailableEncumbrance
 }
 
-// GetIntegration returns bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayload.Integration, and is useful for accessing the field via an interface.
-func (v *bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayload) GetIntegration() bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration {
-	return v.Integration
+// balanceFieldsAvailableEncumbranceBalanceAmount includes the requested fields of the GraphQL type BalanceAmount.
+type balanceFieldsAvailableEncumbranceBalanceAmount struct {
+	balanceAmountFields `json:"-"`
 }
 
-// bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration includes the requested fields of the GraphQL type BigQueryIntegration.
-type bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration struct {
-	IntegrationId string  `json:"integrationId"`
-	Name          string  `json:"name"`
-	Description   *string `json:"description"`
-	GcpProjectId  string  `json:"gcpProjectId"`
-	GcpDatasetId  string  `json:"gcpDatasetId"`
+// GetDrBalance returns balanceFieldsAvailableEncumbranceBalanceAmount.DrBalance, and is useful for accessing the field via an interface.
+func (v *balanceFieldsAvailableEncumbranceBalanceAmount) GetDrBalance() balanceAmountFieldsDrBalanceMoney {
+	return v.balanceAmountFields.DrBalance
 }
 
-// GetIntegrationId returns bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration.IntegrationId, and is useful for accessing the field via an interface.
-func (v *bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration) GetIntegrationId() string {
-	return v.IntegrationId
+// GetCrBalance returns balanceFieldsAvailableEncumbranceBalanceAmount.CrBalance, and is useful for accessing the field via an interface.
+func (v *balanceFieldsAvailableEncumbranceBalanceAmount) GetCrBalance() balanceAmountFieldsCrBalanceMoney {
+	return v.balanceAmountFields.CrBalance
 }
 
-// GetName returns bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration.Name, and is useful for accessing the field via an interface.
-func (v *bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration) GetName() string {
-	return v.Name
+// GetNormalBalance returns balanceFieldsAvailableEncumbranceBalanceAmount.NormalBalance, and is useful for accessing the field via an interface.
+func (v *balanceFieldsAvailableEncumbranceBalanceAmount) GetNormalBalance() balanceAmountFieldsNormalBalanceMoney {
+	return v.balanceAmountFields.NormalBalance
 }
 
-// GetDescription returns bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration.Description, and is useful for accessing the field via an interface.
-func (v *bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration) GetDescription() *string {
-	return v.Description
+func (v *balanceFieldsAvailableEncumbranceBalanceAmount) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*balanceFieldsAvailableEncumbranceBalanceAmount
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.balanceFieldsAvailableEncumbranceBalanceAmount = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.balanceAmountFields)
+	if err != nil {
+		return err
+	}
+	return nil
 }
 
-// GetGcpProjectId returns bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration.GcpProjectId, and is useful for accessing the field via an interface.
-func (v *bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration) GetGcpProjectId() string {
-	return v.GcpProjectId
+type __premarshalbalanceFieldsAvailableEncumbranceBalanceAmount struct {
+	DrBalance balanceAmountFieldsDrBalanceMoney `json:"drBalance"`
+
+	CrBalance balanceAmountFieldsCrBalanceMoney `json:"crBalance"`
+
+	NormalBalance balanceAmountFieldsNormalBalanceMoney `json:"normalBalance"`
 }
 
-// GetGcpDatasetId returns bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration.GcpDatasetId, and is useful for accessing the field via an interface.
-func (v *bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration) GetGcpDatasetId() string {
-	return v.GcpDatasetId
+func (v *balanceFieldsAvailableEncumbranceBalanceAmount) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// bigQueryIntegrationCreateResponse is returned by bigQueryIntegrationCreate on success.
-type bigQueryIntegrationCreateResponse struct {
-	BigQuery bigQueryIntegrationCreateBigQueryBigQueryMutation `json:"bigQuery"`
+func (v *balanceFieldsAvailableEncumbranceBalanceAmount) __premarshalJSON() (*__premarshalbalanceFieldsAvailableEncumbranceBalanceAmount, error) {
+	var retval __premarshalbalanceFieldsAvailableEncumbranceBalanceAmount
+
+	retval.DrBalance = v.balanceAmountFields.DrBalance
+	retval.CrBalance = v.balanceAmountFields.CrBalance
+	retval.NormalBalance = v.balanceAmountFields.NormalBalance
+	return &retval, nil
 }
 
-// GetBigQuery returns bigQueryIntegrationCreateResponse.BigQuery, and is useful for accessing the field via an interface.
-func (v *bigQueryIntegrationCreateResponse) GetBigQuery() bigQueryIntegrationCreateBigQueryBigQueryMutation {
-	return v.BigQuery
+// balanceFieldsAvailablePendingBalanceAmount includes the requested fields of the GraphQL type BalanceAmount.
+type balanceFieldsAvailablePendingBalanceAmount struct {
+	balanceAmountFields `json:"-"`
 }
 
-// bigQueryIntegrationGetBigQueryBigQueryQuery includes the requested fields of the GraphQL type BigQueryQuery.
-type bigQueryIntegrationGetBigQueryBigQueryQuery struct {
-	Integration *bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration `json:"integration"`
+// GetDrBalance returns balanceFieldsAvailablePendingBalanceAmount.DrBalance, and is useful for accessing the field via an interface.
+func (v *balanceFieldsAvailablePendingBalanceAmount) GetDrBalance() balanceAmountFieldsDrBalanceMoney {
+	return v.balanceAmountFields.DrBalance
 }
 
-// GetIntegration returns bigQueryIntegrationGetBigQueryBigQueryQuery.Integration, and is useful for accessing the field via an interface.
-func (v *bigQueryIntegrationGetBigQueryBigQueryQuery) GetIntegration() *bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration {
-	return v.Integration
+// GetCrBalance returns balanceFieldsAvailablePendingBalanceAmount.CrBalance, and is useful for accessing the field via an interface.
+func (v *balanceFieldsAvailablePendingBalanceAmount) GetCrBalance() balanceAmountFieldsCrBalanceMoney {
+	return v.balanceAmountFields.CrBalance
 }
 
-// bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration includes the requested fields of the GraphQL type BigQueryIntegration.
-type bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration struct {
-	IntegrationId string  `json:"integrationId"`
-	Name          string  `json:"name"`
-	Description   *string `json:"description"`
-	GcpProjectId  string  `json:"gcpProjectId"`
-	GcpDatasetId  string  `json:"gcpDatasetId"`
+// GetNormalBalance returns balanceFieldsAvailablePendingBalanceAmount.NormalBalance, and is useful for accessing the field via an interface.
+func (v *balanceFieldsAvailablePendingBalanceAmount) GetNormalBalance() balanceAmountFieldsNormalBalanceMoney {
+	return v.balanceAmountFields.NormalBalance
 }
 
-// GetIntegrationId returns bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration.IntegrationId, and is useful for accessing the field via an interface.
-func (v *bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration) GetIntegrationId() string {
-	return v.IntegrationId
+func (v *balanceFieldsAvailablePendingBalanceAmount) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*balanceFieldsAvailablePendingBalanceAmount
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.balanceFieldsAvailablePendingBalanceAmount = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.balanceAmountFields)
+	if err != nil {
+		return err
+	}
+	return nil
 }
 
-// GetName returns bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration.Name, and is useful for accessing the field via an interface.
-func (v *bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration) GetName() string {
-	return v.Name
+type __premarshalbalanceFieldsAvailablePendingBalanceAmount struct {
+	DrBalance balanceAmountFieldsDrBalanceMoney `json:"drBalance"`
+
+	CrBalance balanceAmountFieldsCrBalanceMoney `json:"crBalance"`
+
+	NormalBalance balanceAmountFieldsNormalBalanceMoney `json:"normalBalance"`
 }
 
-// GetDescription returns bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration.Description, and is useful for accessing the field via an interface.
-func (v *bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration) GetDescription() *string {
-	return v.Description
+func (v *balanceFieldsAvailablePendingBalanceAmount) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// GetGcpProjectId returns bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration.GcpProjectId, and is useful for accessing the field via an interface.
-func (v *bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration) GetGcpProjectId() string {
-	return v.GcpProjectId
+func (v *balanceFieldsAvailablePendingBalanceAmount) __premarshalJSON() (*__premarshalbalanceFieldsAvailablePendingBalanceAmount, error) {
+	var retval __premarshalbalanceFieldsAvailablePendingBalanceAmount
+
+	retval.DrBalance = v.balanceAmountFields.DrBalance
+	retval.CrBalance = v.balanceAmountFields.CrBalance
+	retval.NormalBalance = v.balanceAmountFields.NormalBalance
+	return &retval, nil
 }
 
-// GetGcpDatasetId returns bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration.GcpDatasetId, and is useful for accessing the field via an interface.
-func (v *bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration) GetGcpDatasetId() string {
-	return v.GcpDatasetId
+// balanceFieldsAvailableSettledBalanceAmount includes the requested fields of the GraphQL type BalanceAmount.
+type balanceFieldsAvailableSettledBalanceAmount struct {
+	balanceAmountFields `json:"-"`
 }
 
-// bigQueryIntegrationGetResponse is returned by bigQueryIntegrationGet on success.
-type bigQueryIntegrationGetResponse struct {
-	BigQuery bigQueryIntegrationGetBigQueryBigQueryQuery `json:"bigQuery"`
+// GetDrBalance returns balanceFieldsAvailableSettledBalanceAmount.DrBalance, and is useful for accessing the field via an interface.
+func (v *balanceFieldsAvailableSettledBalanceAmount) GetDrBalance() balanceAmountFieldsDrBalanceMoney {
+	return v.balanceAmountFields.DrBalance
 }
 
-// GetBigQuery returns bigQueryIntegrationGetResponse.BigQuery, and is useful for accessing the field via an interface.
-func (v *bigQueryIntegrationGetResponse) GetBigQuery() bigQueryIntegrationGetBigQueryBigQueryQuery {
-	return v.BigQuery
+// GetCrBalance returns balanceFieldsAvailableSettledBalanceAmount.CrBalance, and is useful for accessing the field via an interface.
+func (v *balanceFieldsAvailableSettledBalanceAmount) GetCrBalance() balanceAmountFieldsCrBalanceMoney {
+	return v.balanceAmountFields.CrBalance
 }
 
-// journalCreateJournalCreateJournalCreatePayload includes the requested fields of the GraphQL type JournalCreatePayload.
-type journalCreateJournalCreateJournalCreatePayload struct {
-	Journal journalCreateJournalCreateJournalCreatePayloadJournal `json:"journal"`
+// GetNormalBalance returns balanceFieldsAvailableSettledBalanceAmount.NormalBalance, and is useful for accessing the field via an interface.
+func (v *balanceFieldsAvailableSettledBalanceAmount) GetNormalBalance() balanceAmountFieldsNormalBalanceMoney {
+	return v.balanceAmountFields.NormalBalance
 }
 
-// GetJournal returns journalCreateJournalCreateJournalCreatePayload.Journal, and is useful for accessing the field via an interface.
+func (v *balanceFieldsAvailableSettledBalanceAmount) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*balanceFieldsAvailableSettledBalanceAmount
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.balanceFieldsAvailableSettledBalanceAmount = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.balanceAmountFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalbalanceFieldsAvailableSettledBalanceAmount struct {
+	DrBalance balanceAmountFieldsDrBalanceMoney `json:"drBalance"`
+
+	CrBalance balanceAmountFieldsCrBalanceMoney `json:"crBalance"`
+
+	NormalBalance balanceAmountFieldsNormalBalanceMoney `json:"normalBalance"`
+}
+
+func (v *balanceFieldsAvailableSettledBalanceAmount) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *balanceFieldsAvailableSettledBalanceAmount) __premarshalJSON() (*__premarshalbalanceFieldsAvailableSettledBalanceAmount, error) {
+	var retval __premarshalbalanceFieldsAvailableSettledBalanceAmount
+
+	retval.DrBalance = v.balanceAmountFields.DrBalance
+	retval.CrBalance = v.balanceAmountFields.CrBalance
+	retval.NormalBalance = v.balanceAmountFields.NormalBalance
+	return &retval, nil
+}
+
+// balanceFieldsEncumbranceBalanceAmount includes the requested fields of the GraphQL type BalanceAmount.
+type balanceFieldsEncumbranceBalanceAmount struct {
+	balanceAmountFields `json:"-"`
+}
+
+// GetDrBalance returns balanceFieldsEncumbranceBalanceAmount.DrBalance, and is useful for accessing the field via an interface.
+func (v *balanceFieldsEncumbranceBalanceAmount) GetDrBalance() balanceAmountFieldsDrBalanceMoney {
+	return v.balanceAmountFields.DrBalance
+}
+
+// GetCrBalance returns balanceFieldsEncumbranceBalanceAmount.CrBalance, and is useful for accessing the field via an interface.
+func (v *balanceFieldsEncumbranceBalanceAmount) GetCrBalance() balanceAmountFieldsCrBalanceMoney {
+	return v.balanceAmountFields.CrBalance
+}
+
+// GetNormalBalance returns balanceFieldsEncumbranceBalanceAmount.NormalBalance, and is useful for accessing the field via an interface.
+func (v *balanceFieldsEncumbranceBalanceAmount) GetNormalBalance() balanceAmountFieldsNormalBalanceMoney {
+	return v.balanceAmountFields.NormalBalance
+}
+
+func (v *balanceFieldsEncumbranceBalanceAmount) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*balanceFieldsEncumbranceBalanceAmount
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.balanceFieldsEncumbranceBalanceAmount = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.balanceAmountFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalbalanceFieldsEncumbranceBalanceAmount struct {
+	DrBalance balanceAmountFieldsDrBalanceMoney `json:"drBalance"`
+
+	CrBalance balanceAmountFieldsCrBalanceMoney `json:"crBalance"`
+
+	NormalBalance balanceAmountFieldsNormalBalanceMoney `json:"normalBalance"`
+}
+
+func (v *balanceFieldsEncumbranceBalanceAmount) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *balanceFieldsEncumbranceBalanceAmount) __premarshalJSON() (*__premarshalbalanceFieldsEncumbranceBalanceAmount, error) {
+	var retval __premarshalbalanceFieldsEncumbranceBalanceAmount
+
+	retval.DrBalance = v.balanceAmountFields.DrBalance
+	retval.CrBalance = v.balanceAmountFields.CrBalance
+	retval.NormalBalance = v.balanceAmountFields.NormalBalance
+	return &retval, nil
+}
+
+// balanceFieldsPendingBalanceAmount includes the requested fields of the GraphQL type BalanceAmount.
+type balanceFieldsPendingBalanceAmount struct {
+	balanceAmountFields `json:"-"`
+}
+
+// GetDrBalance returns balanceFieldsPendingBalanceAmount.DrBalance, and is useful for accessing the field via an interface.
+func (v *balanceFieldsPendingBalanceAmount) GetDrBalance() balanceAmountFieldsDrBalanceMoney {
+	return v.balanceAmountFields.DrBalance
+}
+
+// GetCrBalance returns balanceFieldsPendingBalanceAmount.CrBalance, and is useful for accessing the field via an interface.
+func (v *balanceFieldsPendingBalanceAmount) GetCrBalance() balanceAmountFieldsCrBalanceMoney {
+	return v.balanceAmountFields.CrBalance
+}
+
+// GetNormalBalance returns balanceFieldsPendingBalanceAmount.NormalBalance, and is useful for accessing the field via an interface.
+func (v *balanceFieldsPendingBalanceAmount) GetNormalBalance() balanceAmountFieldsNormalBalanceMoney {
+	return v.balanceAmountFields.NormalBalance
+}
+
+func (v *balanceFieldsPendingBalanceAmount) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*balanceFieldsPendingBalanceAmount
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.balanceFieldsPendingBalanceAmount = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.balanceAmountFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalbalanceFieldsPendingBalanceAmount struct {
+	DrBalance balanceAmountFieldsDrBalanceMoney `json:"drBalance"`
+
+	CrBalance balanceAmountFieldsCrBalanceMoney `json:"crBalance"`
+
+	NormalBalance balanceAmountFieldsNormalBalanceMoney `json:"normalBalance"`
+}
+
+func (v *balanceFieldsPendingBalanceAmount) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *balanceFieldsPendingBalanceAmount) __premarshalJSON() (*__premarshalbalanceFieldsPendingBalanceAmount, error) {
+	var retval __premarshalbalanceFieldsPendingBalanceAmount
+
+	retval.DrBalance = v.balanceAmountFields.DrBalance
+	retval.CrBalance = v.balanceAmountFields.CrBalance
+	retval.NormalBalance = v.balanceAmountFields.NormalBalance
+	return &retval, nil
+}
+
+// balanceFieldsSettledBalanceAmount includes the requested fields of the GraphQL type BalanceAmount.
+type balanceFieldsSettledBalanceAmount struct {
+	balanceAmountFields `json:"-"`
+}
+
+// GetDrBalance returns balanceFieldsSettledBalanceAmount.DrBalance, and is useful for accessing the field via an interface.
+func (v *balanceFieldsSettledBalanceAmount) GetDrBalance() balanceAmountFieldsDrBalanceMoney {
+	return v.balanceAmountFields.DrBalance
+}
+
+// GetCrBalance returns balanceFieldsSettledBalanceAmount.CrBalance, and is useful for accessing the field via an interface.
+func (v *balanceFieldsSettledBalanceAmount) GetCrBalance() balanceAmountFieldsCrBalanceMoney {
+	return v.balanceAmountFields.CrBalance
+}
+
+// GetNormalBalance returns balanceFieldsSettledBalanceAmount.NormalBalance, and is useful for accessing the field via an interface.
+func (v *balanceFieldsSettledBalanceAmount) GetNormalBalance() balanceAmountFieldsNormalBalanceMoney {
+	return v.balanceAmountFields.NormalBalance
+}
+
+func (v *balanceFieldsSettledBalanceAmount) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*balanceFieldsSettledBalanceAmount
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.balanceFieldsSettledBalanceAmount = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.balanceAmountFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalbalanceFieldsSettledBalanceAmount struct {
+	DrBalance balanceAmountFieldsDrBalanceMoney `json:"drBalance"`
+
+	CrBalance balanceAmountFieldsCrBalanceMoney `json:"crBalance"`
+
+	NormalBalance balanceAmountFieldsNormalBalanceMoney `json:"normalBalance"`
+}
+
+func (v *balanceFieldsSettledBalanceAmount) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *balanceFieldsSettledBalanceAmount) __premarshalJSON() (*__premarshalbalanceFieldsSettledBalanceAmount, error) {
+	var retval __premarshalbalanceFieldsSettledBalanceAmount
+
+	retval.DrBalance = v.balanceAmountFields.DrBalance
+	retval.CrBalance = v.balanceAmountFields.CrBalance
+	retval.NormalBalance = v.balanceAmountFields.NormalBalance
+	return &retval, nil
+}
+
+// balanceGetBalance includes the requested fields of the GraphQL type Balance.
+type balanceGetBalance struct {
+	balanceFields `json:"-"`
+}
+
+// GetCurrency returns balanceGetBalance.Currency, and is useful for accessing the field via an interface.
+func (v *balanceGetBalance) GetCurrency() string { return v.balanceFields.Currency }
+
+// GetSettled returns balanceGetBalance.Settled, and is useful for accessing the field via an interface.
+func (v *balanceGetBalance) GetSettled() balanceFieldsSettledBalanceAmount {
+	return v.balanceFields.Settled
+}
+
+// GetPending returns balanceGetBalance.Pending, and is useful for accessing the field via an interface.
+func (v *balanceGetBalance) GetPending() balanceFieldsPendingBalanceAmount {
+	return v.balanceFields.Pending
+}
+
+// GetEncumbrance returns balanceGetBalance.Encumbrance, and is useful for accessing the field via an interface.
+func (v *balanceGetBalance) GetEncumbrance() balanceFieldsEncumbranceBalanceAmount {
+	return v.balanceFields.Encumbrance
+}
+
+// GetAvailableSettled returns balanceGetBalance.AvailableSettled, and is useful for accessing the field via an interface.
+func (v *balanceGetBalance) GetAvailableSettled() balanceFieldsAvailableSettledBalanceAmount {
+	return v.balanceFields.AvailableSettled
+}
+
+// GetAvailablePending returns balanceGetBalance.AvailablePending, and is useful for accessing the field via an interface.
+func (v *balanceGetBalance) GetAvailablePending() balanceFieldsAvailablePendingBalanceAmount {
+	return v.balanceFields.AvailablePending
+}
+
+// GetAvailableEncumbrance returns balanceGetBalance.AvailableEncumbrance, and is useful for accessing the field via an interface.
+func (v *balanceGetBalance) GetAvailableEncumbrance() balanceFieldsAvailableEncumbranceBalanceAmount {
+	return v.balanceFields.AvailableEncumbrance
+}
+
+func (v *balanceGetBalance) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*balanceGetBalance
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.balanceGetBalance = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.balanceFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalbalanceGetBalance struct {
+	Currency string `json:"currency"`
+
+	Settled balanceFieldsSettledBalanceAmount `json:"settled"`
+
+	Pending balanceFieldsPendingBalanceAmount `json:"pending"`
+
+	Encumbrance balanceFieldsEncumbranceBalanceAmount `json:"encumbrance"`
+
+	AvailableSettled balanceFieldsAvailableSettledBalanceAmount `json:"availableSettled"`
+
+	AvailablePending balanceFieldsAvailablePendingBalanceAmount `json:"availablePending"`
+
+	AvailableEncumbrance balanceFieldsAvailableEncumbranceBalanceAmount `json:"availableEncumbrance"`
+}
+
+func (v *balanceGetBalance) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *balanceGetBalance) __premarshalJSON() (*__premarshalbalanceGetBalance, error) {
+	var retval __premarshalbalanceGetBalance
+
+	retval.Currency = v.balanceFields.Currency
+	retval.Settled = v.balanceFields.Settled
+	retval.Pending = v.balanceFields.Pending
+	retval.Encumbrance = v.balanceFields.Encumbrance
+	retval.AvailableSettled = v.balanceFields.AvailableSettled
+	retval.AvailablePending = v.balanceFields.AvailablePending
+	retval.AvailableEncumbrance = v.balanceFields.AvailableEncumbrance
+	return &retval, nil
+}
+
+// balanceGetResponse is returned by balanceGet on success.
+type balanceGetResponse struct {
+	Balance *balanceGetBalance `json:"balance"`
+}
+
+// GetBalance returns balanceGetResponse.Balance, and is useful for accessing the field via an interface.
+func (v *balanceGetResponse) GetBalance() *balanceGetBalance { return v.Balance }
+
+// bfxIntegrationCreateBitfinexBitfinexMutation includes the requested fields of the GraphQL type BitfinexMutation.
+type bfxIntegrationCreateBitfinexBitfinexMutation struct {
+	IntegrationCreate bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayload `json:"integrationCreate"`
+}
+
+// GetIntegrationCreate returns bfxIntegrationCreateBitfinexBitfinexMutation.IntegrationCreate, and is useful for accessing the field via an interface.
+func (v *bfxIntegrationCreateBitfinexBitfinexMutation) GetIntegrationCreate() bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayload {
+	return v.IntegrationCreate
+}
+
+// bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayload includes the requested fields of the GraphQL type BfxIntegrationCreatePayload.
+type bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayload struct {
+	Integration bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayloadIntegrationBfxIntegration `json:"integration"`
+}
+
+// GetIntegration returns bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayload.Integration, and is useful for accessing the field via an interface.
+func (v *bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayload) GetIntegration() bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayloadIntegrationBfxIntegration {
+	return v.Integration
+}
+
+// bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayloadIntegrationBfxIntegration includes the requested fields of the GraphQL type BfxIntegration.
+type bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayloadIntegrationBfxIntegration struct {
+	IntegrationId    string  `json:"integrationId"`
+	Name             string  `json:"name"`
+	Description      *string `json:"description"`
+	OmnibusAccountId string  `json:"omnibusAccountId"`
+}
+
+// GetIntegrationId returns bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayloadIntegrationBfxIntegration.IntegrationId, and is useful for accessing the field via an interface.
+func (v *bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayloadIntegrationBfxIntegration) GetIntegrationId() string {
+	return v.IntegrationId
+}
+
+// GetName returns bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayloadIntegrationBfxIntegration.Name, and is useful for accessing the field via an interface.
+func (v *bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayloadIntegrationBfxIntegration) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayloadIntegrationBfxIntegration.Description, and is useful for accessing the field via an interface.
+func (v *bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayloadIntegrationBfxIntegration) GetDescription() *string {
+	return v.Description
+}
+
+// GetOmnibusAccountId returns bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayloadIntegrationBfxIntegration.OmnibusAccountId, and is useful for accessing the field via an interface.
+func (v *bfxIntegrationCreateBitfinexBitfinexMutationIntegrationCreateBfxIntegrationCreatePayloadIntegrationBfxIntegration) GetOmnibusAccountId() string {
+	return v.OmnibusAccountId
+}
+
+// bfxIntegrationCreateResponse is returned by bfxIntegrationCreate on success.
+type bfxIntegrationCreateResponse struct {
+	Bitfinex bfxIntegrationCreateBitfinexBitfinexMutation `json:"bitfinex"`
+}
+
+// GetBitfinex returns bfxIntegrationCreateResponse.Bitfinex, and is useful for accessing the field via an interface.
+func (v *bfxIntegrationCreateResponse) GetBitfinex() bfxIntegrationCreateBitfinexBitfinexMutation {
+	return v.Bitfinex
+}
+
+// bfxIntegrationGetBitfinexBitfinexQuery includes the requested fields of the GraphQL type BitfinexQuery.
+type bfxIntegrationGetBitfinexBitfinexQuery struct {
+	Integration *bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration `json:"integration"`
+}
+
+// GetIntegration returns bfxIntegrationGetBitfinexBitfinexQuery.Integration, and is useful for accessing the field via an interface.
+func (v *bfxIntegrationGetBitfinexBitfinexQuery) GetIntegration() *bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration {
+	return v.Integration
+}
+
+// bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration includes the requested fields of the GraphQL type BfxIntegration.
+type bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration struct {
+	IntegrationId    string  `json:"integrationId"`
+	Name             string  `json:"name"`
+	Description      *string `json:"description"`
+	JournalId        string  `json:"journalId"`
+	OmnibusAccountId string  `json:"omnibusAccountId"`
+}
+
+// GetIntegrationId returns bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration.IntegrationId, and is useful for accessing the field via an interface.
+func (v *bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration) GetIntegrationId() string {
+	return v.IntegrationId
+}
+
+// GetName returns bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration.Name, and is useful for accessing the field via an interface.
+func (v *bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration.Description, and is useful for accessing the field via an interface.
+func (v *bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration) GetDescription() *string {
+	return v.Description
+}
+
+// GetJournalId returns bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration.JournalId, and is useful for accessing the field via an interface.
+func (v *bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration) GetJournalId() string {
+	return v.JournalId
+}
+
+// GetOmnibusAccountId returns bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration.OmnibusAccountId, and is useful for accessing the field via an interface.
+func (v *bfxIntegrationGetBitfinexBitfinexQueryIntegrationBfxIntegration) GetOmnibusAccountId() string {
+	return v.OmnibusAccountId
+}
+
+// bfxIntegrationGetResponse is returned by bfxIntegrationGet on success.
+type bfxIntegrationGetResponse struct {
+	Bitfinex bfxIntegrationGetBitfinexBitfinexQuery `json:"bitfinex"`
+}
+
+// GetBitfinex returns bfxIntegrationGetResponse.Bitfinex, and is useful for accessing the field via an interface.
+func (v *bfxIntegrationGetResponse) GetBitfinex() bfxIntegrationGetBitfinexBitfinexQuery {
+	return v.Bitfinex
+}
+
+// bigQueryIntegrationCreateBigQueryBigQueryMutation includes the requested fields of the GraphQL type BigQueryMutation.
+type bigQueryIntegrationCreateBigQueryBigQueryMutation struct {
+	IntegrationCreate bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayload `json:"integrationCreate"`
+}
+
+// GetIntegrationCreate returns bigQueryIntegrationCreateBigQueryBigQueryMutation.IntegrationCreate, and is useful for accessing the field via an interface.
+func (v *bigQueryIntegrationCreateBigQueryBigQueryMutation) GetIntegrationCreate() bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayload {
+	return v.IntegrationCreate
+}
+
+// bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayload includes the requested fields of the GraphQL type BigQueryIntegrationCreatePayload.
+type bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayload struct {
+	Integration bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration `json:"integration"`
+}
+
+// GetIntegration returns bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayload.Integration, and is useful for accessing the field via an interface.
+func (v *bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayload) GetIntegration() bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration {
+	return v.Integration
+}
+
+// bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration includes the requested fields of the GraphQL type BigQueryIntegration.
+type bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration struct {
+	IntegrationId string  `json:"integrationId"`
+	Name          string  `json:"name"`
+	Description   *string `json:"description"`
+	GcpProjectId  string  `json:"gcpProjectId"`
+	GcpDatasetId  string  `json:"gcpDatasetId"`
+}
+
+// GetIntegrationId returns bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration.IntegrationId, and is useful for accessing the field via an interface.
+func (v *bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration) GetIntegrationId() string {
+	return v.IntegrationId
+}
+
+// GetName returns bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration.Name, and is useful for accessing the field via an interface.
+func (v *bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration.Description, and is useful for accessing the field via an interface.
+func (v *bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration) GetDescription() *string {
+	return v.Description
+}
+
+// GetGcpProjectId returns bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration.GcpProjectId, and is useful for accessing the field via an interface.
+func (v *bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration) GetGcpProjectId() string {
+	return v.GcpProjectId
+}
+
+// GetGcpDatasetId returns bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration.GcpDatasetId, and is useful for accessing the field via an interface.
+func (v *bigQueryIntegrationCreateBigQueryBigQueryMutationIntegrationCreateBigQueryIntegrationCreatePayloadIntegrationBigQueryIntegration) GetGcpDatasetId() string {
+	return v.GcpDatasetId
+}
+
+// bigQueryIntegrationCreateResponse is returned by bigQueryIntegrationCreate on success.
+type bigQueryIntegrationCreateResponse struct {
+	BigQuery bigQueryIntegrationCreateBigQueryBigQueryMutation `json:"bigQuery"`
+}
+
+// GetBigQuery returns bigQueryIntegrationCreateResponse.BigQuery, and is useful for accessing the field via an interface.
+func (v *bigQueryIntegrationCreateResponse) GetBigQuery() bigQueryIntegrationCreateBigQueryBigQueryMutation {
+	return v.BigQuery
+}
+
+// bigQueryIntegrationGetBigQueryBigQueryQuery includes the requested fields of the GraphQL type BigQueryQuery.
+type bigQueryIntegrationGetBigQueryBigQueryQuery struct {
+	Integration *bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration `json:"integration"`
+}
+
+// GetIntegration returns bigQueryIntegrationGetBigQueryBigQueryQuery.Integration, and is useful for accessing the field via an interface.
+func (v *bigQueryIntegrationGetBigQueryBigQueryQuery) GetIntegration() *bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration {
+	return v.Integration
+}
+
+// bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration includes the requested fields of the GraphQL type BigQueryIntegration.
+type bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration struct {
+	IntegrationId string  `json:"integrationId"`
+	Name          string  `json:"name"`
+	Description   *string `json:"description"`
+	GcpProjectId  string  `json:"gcpProjectId"`
+	GcpDatasetId  string  `json:"gcpDatasetId"`
+}
+
+// GetIntegrationId returns bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration.IntegrationId, and is useful for accessing the field via an interface.
+func (v *bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration) GetIntegrationId() string {
+	return v.IntegrationId
+}
+
+// GetName returns bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration.Name, and is useful for accessing the field via an interface.
+func (v *bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration.Description, and is useful for accessing the field via an interface.
+func (v *bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration) GetDescription() *string {
+	return v.Description
+}
+
+// GetGcpProjectId returns bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration.GcpProjectId, and is useful for accessing the field via an interface.
+func (v *bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration) GetGcpProjectId() string {
+	return v.GcpProjectId
+}
+
+// GetGcpDatasetId returns bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration.GcpDatasetId, and is useful for accessing the field via an interface.
+func (v *bigQueryIntegrationGetBigQueryBigQueryQueryIntegrationBigQueryIntegration) GetGcpDatasetId() string {
+	return v.GcpDatasetId
+}
+
+// bigQueryIntegrationGetResponse is returned by bigQueryIntegrationGet on success.
+type bigQueryIntegrationGetResponse struct {
+	BigQuery bigQueryIntegrationGetBigQueryBigQueryQuery `json:"bigQuery"`
+}
+
+// GetBigQuery returns bigQueryIntegrationGetResponse.BigQuery, and is useful for accessing the field via an interface.
+func (v *bigQueryIntegrationGetResponse) GetBigQuery() bigQueryIntegrationGetBigQueryBigQueryQuery {
+	return v.BigQuery
+}
+
+// calaOutboxImportJobCreateCalaOutboxImportJobCreateCalaOutboxImportJobCreatePayload includes the requested fields of the GraphQL type CalaOutboxImportJobCreatePayload.
+type calaOutboxImportJobCreateCalaOutboxImportJobCreateCalaOutboxImportJobCreatePayload struct {
+	Job calaOutboxImportJobCreateCalaOutboxImportJobCreateCalaOutboxImportJobCreatePayloadJob `json:"job"`
+}
+
+// GetJob returns calaOutboxImportJobCreateCalaOutboxImportJobCreateCalaOutboxImportJobCreatePayload.Job, and is useful for accessing the field via an interface.
+func (v *calaOutboxImportJobCreateCalaOutboxImportJobCreateCalaOutboxImportJobCreatePayload) GetJob() calaOutboxImportJobCreateCalaOutboxImportJobCreateCalaOutboxImportJobCreatePayloadJob {
+	return v.Job
+}
+
+// calaOutboxImportJobCreateCalaOutboxImportJobCreateCalaOutboxImportJobCreatePayloadJob includes the requested fields of the GraphQL type Job.
+type calaOutboxImportJobCreateCalaOutboxImportJobCreateCalaOutboxImportJobCreatePayloadJob struct {
+	JobId       string  `json:"jobId"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+}
+
+// GetJobId returns calaOutboxImportJobCreateCalaOutboxImportJobCreateCalaOutboxImportJobCreatePayloadJob.JobId, and is useful for accessing the field via an interface.
+func (v *calaOutboxImportJobCreateCalaOutboxImportJobCreateCalaOutboxImportJobCreatePayloadJob) GetJobId() string {
+	return v.JobId
+}
+
+// GetName returns calaOutboxImportJobCreateCalaOutboxImportJobCreateCalaOutboxImportJobCreatePayloadJob.Name, and is useful for accessing the field via an interface.
+func (v *calaOutboxImportJobCreateCalaOutboxImportJobCreateCalaOutboxImportJobCreatePayloadJob) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns calaOutboxImportJobCreateCalaOutboxImportJobCreateCalaOutboxImportJobCreatePayloadJob.Description, and is useful for accessing the field via an interface.
+func (v *calaOutboxImportJobCreateCalaOutboxImportJobCreateCalaOutboxImportJobCreatePayloadJob) GetDescription() *string {
+	return v.Description
+}
+
+// calaOutboxImportJobCreateResponse is returned by calaOutboxImportJobCreate on success.
+type calaOutboxImportJobCreateResponse struct {
+	CalaOutboxImportJobCreate calaOutboxImportJobCreateCalaOutboxImportJobCreateCalaOutboxImportJobCreatePayload `json:"calaOutboxImportJobCreate"`
+}
+
+// GetCalaOutboxImportJobCreate returns calaOutboxImportJobCreateResponse.CalaOutboxImportJobCreate, and is useful for accessing the field via an interface.
+func (v *calaOutboxImportJobCreateResponse) GetCalaOutboxImportJobCreate() calaOutboxImportJobCreateCalaOutboxImportJobCreateCalaOutboxImportJobCreatePayload {
+	return v.CalaOutboxImportJobCreate
+}
+
+// jobsListJobsJobConnection includes the requested fields of the GraphQL type JobConnection.
+type jobsListJobsJobConnection struct {
+	// Information to aid in pagination.
+	PageInfo jobsListJobsJobConnectionPageInfo `json:"pageInfo"`
+	// A list of nodes.
+	Nodes []jobsListJobsJobConnectionNodesJob `json:"nodes"`
+}
+
+// GetPageInfo returns jobsListJobsJobConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *jobsListJobsJobConnection) GetPageInfo() jobsListJobsJobConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetNodes returns jobsListJobsJobConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *jobsListJobsJobConnection) GetNodes() []jobsListJobsJobConnectionNodesJob { return v.Nodes }
+
+// jobsListJobsJobConnectionNodesJob includes the requested fields of the GraphQL type Job.
+type jobsListJobsJobConnectionNodesJob struct {
+	JobId       string  `json:"jobId"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+}
+
+// GetJobId returns jobsListJobsJobConnectionNodesJob.JobId, and is useful for accessing the field via an interface.
+func (v *jobsListJobsJobConnectionNodesJob) GetJobId() string { return v.JobId }
+
+// GetName returns jobsListJobsJobConnectionNodesJob.Name, and is useful for accessing the field via an interface.
+func (v *jobsListJobsJobConnectionNodesJob) GetName() string { return v.Name }
+
+// GetDescription returns jobsListJobsJobConnectionNodesJob.Description, and is useful for accessing the field via an interface.
+func (v *jobsListJobsJobConnectionNodesJob) GetDescription() *string { return v.Description }
+
+// jobsListJobsJobConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection
+type jobsListJobsJobConnectionPageInfo struct {
+	// When paginating forwards, are there more items?
+	HasNextPage bool `json:"hasNextPage"`
+	// When paginating forwards, the cursor to continue.
+	EndCursor *string `json:"endCursor"`
+}
+
+// GetHasNextPage returns jobsListJobsJobConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *jobsListJobsJobConnectionPageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+// GetEndCursor returns jobsListJobsJobConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *jobsListJobsJobConnectionPageInfo) GetEndCursor() *string { return v.EndCursor }
+
+// jobsListResponse is returned by jobsList on success.
+type jobsListResponse struct {
+	Jobs jobsListJobsJobConnection `json:"jobs"`
+}
+
+// GetJobs returns jobsListResponse.Jobs, and is useful for accessing the field via an interface.
+func (v *jobsListResponse) GetJobs() jobsListJobsJobConnection { return v.Jobs }
+
+// journalCreateJournalCreateJournalCreatePayload includes the requested fields of the GraphQL type JournalCreatePayload.
+type journalCreateJournalCreateJournalCreatePayload struct {
+	Journal journalCreateJournalCreateJournalCreatePayloadJournal `json:"journal"`
+}
+
+// GetJournal returns journalCreateJournalCreateJournalCreatePayload.Journal, and is useful for accessing the field via an interface.
 func (v *journalCreateJournalCreateJournalCreatePayload) GetJournal() journalCreateJournalCreateJournalCreatePayloadJournal {
 	return v.Journal
 }
@@ -1218,17 +3156,661 @@ func (v *journalUpdateJournalUpdateJournalUpdatePayloadJournal) GetDescription()
 	return v.Description
 }
 
-// GetStatus returns journalUpdateJournalUpdateJournalUpdatePayloadJournal.Status, and is useful for accessing the field via an interface.
-func (v *journalUpdateJournalUpdateJournalUpdatePayloadJournal) GetStatus() Status { return v.Status }
+// GetStatus returns journalUpdateJournalUpdateJournalUpdatePayloadJournal.Status, and is useful for accessing the field via an interface.
+func (v *journalUpdateJournalUpdateJournalUpdatePayloadJournal) GetStatus() Status { return v.Status }
+
+// journalUpdateResponse is returned by journalUpdate on success.
+type journalUpdateResponse struct {
+	JournalUpdate journalUpdateJournalUpdateJournalUpdatePayload `json:"journalUpdate"`
+}
+
+// GetJournalUpdate returns journalUpdateResponse.JournalUpdate, and is useful for accessing the field via an interface.
+func (v *journalUpdateResponse) GetJournalUpdate() journalUpdateJournalUpdateJournalUpdatePayload {
+	return v.JournalUpdate
+}
+
+// serverVersionGetResponse is returned by serverVersionGet on success.
+type serverVersionGetResponse struct {
+	ServerVersion string `json:"serverVersion"`
+}
+
+// GetServerVersion returns serverVersionGetResponse.ServerVersion, and is useful for accessing the field via an interface.
+func (v *serverVersionGetResponse) GetServerVersion() string { return v.ServerVersion }
+
+// transactionGetResponse is returned by transactionGet on success.
+type transactionGetResponse struct {
+	Transaction *transactionGetTransaction `json:"transaction"`
+}
+
+// GetTransaction returns transactionGetResponse.Transaction, and is useful for accessing the field via an interface.
+func (v *transactionGetResponse) GetTransaction() *transactionGetTransaction { return v.Transaction }
+
+// transactionGetTransaction includes the requested fields of the GraphQL type Transaction.
+type transactionGetTransaction struct {
+	TransactionId string  `json:"transactionId"`
+	TxTemplateId  string  `json:"txTemplateId"`
+	JournalId     string  `json:"journalId"`
+	Effective     string  `json:"effective"`
+	CorrelationId string  `json:"correlationId"`
+	ExternalId    *string `json:"externalId"`
+	Description   *string `json:"description"`
+}
+
+// GetTransactionId returns transactionGetTransaction.TransactionId, and is useful for accessing the field via an interface.
+func (v *transactionGetTransaction) GetTransactionId() string { return v.TransactionId }
+
+// GetTxTemplateId returns transactionGetTransaction.TxTemplateId, and is useful for accessing the field via an interface.
+func (v *transactionGetTransaction) GetTxTemplateId() string { return v.TxTemplateId }
+
+// GetJournalId returns transactionGetTransaction.JournalId, and is useful for accessing the field via an interface.
+func (v *transactionGetTransaction) GetJournalId() string { return v.JournalId }
+
+// GetEffective returns transactionGetTransaction.Effective, and is useful for accessing the field via an interface.
+func (v *transactionGetTransaction) GetEffective() string { return v.Effective }
+
+// GetCorrelationId returns transactionGetTransaction.CorrelationId, and is useful for accessing the field via an interface.
+func (v *transactionGetTransaction) GetCorrelationId() string { return v.CorrelationId }
+
+// GetExternalId returns transactionGetTransaction.ExternalId, and is useful for accessing the field via an interface.
+func (v *transactionGetTransaction) GetExternalId() *string { return v.ExternalId }
+
+// GetDescription returns transactionGetTransaction.Description, and is useful for accessing the field via an interface.
+func (v *transactionGetTransaction) GetDescription() *string { return v.Description }
+
+// transactionPostResponse is returned by transactionPost on success.
+type transactionPostResponse struct {
+	TransactionPost transactionPostTransactionPostTransactionPostPayload `json:"transactionPost"`
+}
+
+// GetTransactionPost returns transactionPostResponse.TransactionPost, and is useful for accessing the field via an interface.
+func (v *transactionPostResponse) GetTransactionPost() transactionPostTransactionPostTransactionPostPayload {
+	return v.TransactionPost
+}
+
+// transactionPostTransactionPostTransactionPostPayload includes the requested fields of the GraphQL type TransactionPostPayload.
+type transactionPostTransactionPostTransactionPostPayload struct {
+	Transaction transactionPostTransactionPostTransactionPostPayloadTransaction `json:"transaction"`
+}
+
+// GetTransaction returns transactionPostTransactionPostTransactionPostPayload.Transaction, and is useful for accessing the field via an interface.
+func (v *transactionPostTransactionPostTransactionPostPayload) GetTransaction() transactionPostTransactionPostTransactionPostPayloadTransaction {
+	return v.Transaction
+}
+
+// transactionPostTransactionPostTransactionPostPayloadTransaction includes the requested fields of the GraphQL type Transaction.
+type transactionPostTransactionPostTransactionPostPayloadTransaction struct {
+	TransactionId string  `json:"transactionId"`
+	TxTemplateId  string  `json:"txTemplateId"`
+	JournalId     string  `json:"journalId"`
+	Effective     string  `json:"effective"`
+	CorrelationId string  `json:"correlationId"`
+	ExternalId    *string `json:"externalId"`
+	Description   *string `json:"description"`
+}
+
+// GetTransactionId returns transactionPostTransactionPostTransactionPostPayloadTransaction.TransactionId, and is useful for accessing the field via an interface.
+func (v *transactionPostTransactionPostTransactionPostPayloadTransaction) GetTransactionId() string {
+	return v.TransactionId
+}
+
+// GetTxTemplateId returns transactionPostTransactionPostTransactionPostPayloadTransaction.TxTemplateId, and is useful for accessing the field via an interface.
+func (v *transactionPostTransactionPostTransactionPostPayloadTransaction) GetTxTemplateId() string {
+	return v.TxTemplateId
+}
+
+// GetJournalId returns transactionPostTransactionPostTransactionPostPayloadTransaction.JournalId, and is useful for accessing the field via an interface.
+func (v *transactionPostTransactionPostTransactionPostPayloadTransaction) GetJournalId() string {
+	return v.JournalId
+}
+
+// GetEffective returns transactionPostTransactionPostTransactionPostPayloadTransaction.Effective, and is useful for accessing the field via an interface.
+func (v *transactionPostTransactionPostTransactionPostPayloadTransaction) GetEffective() string {
+	return v.Effective
+}
+
+// GetCorrelationId returns transactionPostTransactionPostTransactionPostPayloadTransaction.CorrelationId, and is useful for accessing the field via an interface.
+func (v *transactionPostTransactionPostTransactionPostPayloadTransaction) GetCorrelationId() string {
+	return v.CorrelationId
+}
+
+// GetExternalId returns transactionPostTransactionPostTransactionPostPayloadTransaction.ExternalId, and is useful for accessing the field via an interface.
+func (v *transactionPostTransactionPostTransactionPostPayloadTransaction) GetExternalId() *string {
+	return v.ExternalId
+}
+
+// GetDescription returns transactionPostTransactionPostTransactionPostPayloadTransaction.Description, and is useful for accessing the field via an interface.
+func (v *transactionPostTransactionPostTransactionPostPayloadTransaction) GetDescription() *string {
+	return v.Description
+}
+
+// txTemplateCreateResponse is returned by txTemplateCreate on success.
+type txTemplateCreateResponse struct {
+	TxTemplateCreate txTemplateCreateTxTemplateCreateTxTemplateCreatePayload `json:"txTemplateCreate"`
+}
+
+// GetTxTemplateCreate returns txTemplateCreateResponse.TxTemplateCreate, and is useful for accessing the field via an interface.
+func (v *txTemplateCreateResponse) GetTxTemplateCreate() txTemplateCreateTxTemplateCreateTxTemplateCreatePayload {
+	return v.TxTemplateCreate
+}
+
+// txTemplateCreateTxTemplateCreateTxTemplateCreatePayload includes the requested fields of the GraphQL type TxTemplateCreatePayload.
+type txTemplateCreateTxTemplateCreateTxTemplateCreatePayload struct {
+	TxTemplate txTemplateCreateTxTemplateCreateTxTemplateCreatePayloadTxTemplate `json:"txTemplate"`
+}
+
+// GetTxTemplate returns txTemplateCreateTxTemplateCreateTxTemplateCreatePayload.TxTemplate, and is useful for accessing the field via an interface.
+func (v *txTemplateCreateTxTemplateCreateTxTemplateCreatePayload) GetTxTemplate() txTemplateCreateTxTemplateCreateTxTemplateCreatePayloadTxTemplate {
+	return v.TxTemplate
+}
+
+// txTemplateCreateTxTemplateCreateTxTemplateCreatePayloadTxTemplate includes the requested fields of the GraphQL type TxTemplate.
+type txTemplateCreateTxTemplateCreateTxTemplateCreatePayloadTxTemplate struct {
+	txTemplateFields `json:"-"`
+}
+
+// GetTxTemplateId returns txTemplateCreateTxTemplateCreateTxTemplateCreatePayloadTxTemplate.TxTemplateId, and is useful for accessing the field via an interface.
+func (v *txTemplateCreateTxTemplateCreateTxTemplateCreatePayloadTxTemplate) GetTxTemplateId() string {
+	return v.txTemplateFields.TxTemplateId
+}
+
+// GetVersion returns txTemplateCreateTxTemplateCreateTxTemplateCreatePayloadTxTemplate.Version, and is useful for accessing the field via an interface.
+func (v *txTemplateCreateTxTemplateCreateTxTemplateCreatePayloadTxTemplate) GetVersion() int {
+	return v.txTemplateFields.Version
+}
+
+// GetCode returns txTemplateCreateTxTemplateCreateTxTemplateCreatePayloadTxTemplate.Code, and is useful for accessing the field via an interface.
+func (v *txTemplateCreateTxTemplateCreateTxTemplateCreatePayloadTxTemplate) GetCode() string {
+	return v.txTemplateFields.Code
+}
+
+// GetDescription returns txTemplateCreateTxTemplateCreateTxTemplateCreatePayloadTxTemplate.Description, and is useful for accessing the field via an interface.
+func (v *txTemplateCreateTxTemplateCreateTxTemplateCreatePayloadTxTemplate) GetDescription() *string {
+	return v.txTemplateFields.Description
+}
+
+// GetParams returns txTemplateCreateTxTemplateCreateTxTemplateCreatePayloadTxTemplate.Params, and is useful for accessing the field via an interface.
+func (v *txTemplateCreateTxTemplateCreateTxTemplateCreatePayloadTxTemplate) GetParams() []txTemplateFieldsParamsParamDefinition {
+	return v.txTemplateFields.Params
+}
+
+// GetTransaction returns txTemplateCreateTxTemplateCreateTxTemplateCreatePayloadTxTemplate.Transaction, and is useful for accessing the field via an interface.
+func (v *txTemplateCreateTxTemplateCreateTxTemplateCreatePayloadTxTemplate) GetTransaction() txTemplateFieldsTransactionTxTemplateTransaction {
+	return v.txTemplateFields.Transaction
+}
+
+// GetEntries returns txTemplateCreateTxTemplateCreateTxTemplateCreatePayloadTxTemplate.Entries, and is useful for accessing the field via an interface.
+func (v *txTemplateCreateTxTemplateCreateTxTemplateCreatePayloadTxTemplate) GetEntries() []txTemplateFieldsEntriesTxTemplateEntry {
+	return v.txTemplateFields.Entries
+}
+
+func (v *txTemplateCreateTxTemplateCreateTxTemplateCreatePayloadTxTemplate) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*txTemplateCreateTxTemplateCreateTxTemplateCreatePayloadTxTemplate
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.txTemplateCreateTxTemplateCreateTxTemplateCreatePayloadTxTemplate = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.txTemplateFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshaltxTemplateCreateTxTemplateCreateTxTemplateCreatePayloadTxTemplate struct {
+	TxTemplateId string `json:"txTemplateId"`
+
+	Version int `json:"version"`
+
+	Code string `json:"code"`
+
+	Description *string `json:"description"`
+
+	Params []txTemplateFieldsParamsParamDefinition `json:"params"`
+
+	Transaction txTemplateFieldsTransactionTxTemplateTransaction `json:"transaction"`
+
+	Entries []txTemplateFieldsEntriesTxTemplateEntry `json:"entries"`
+}
+
+func (v *txTemplateCreateTxTemplateCreateTxTemplateCreatePayloadTxTemplate) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *txTemplateCreateTxTemplateCreateTxTemplateCreatePayloadTxTemplate) __premarshalJSON() (*__premarshaltxTemplateCreateTxTemplateCreateTxTemplateCreatePayloadTxTemplate, error) {
+	var retval __premarshaltxTemplateCreateTxTemplateCreateTxTemplateCreatePayloadTxTemplate
+
+	retval.TxTemplateId = v.txTemplateFields.TxTemplateId
+	retval.Version = v.txTemplateFields.Version
+	retval.Code = v.txTemplateFields.Code
+	retval.Description = v.txTemplateFields.Description
+	retval.Params = v.txTemplateFields.Params
+	retval.Transaction = v.txTemplateFields.Transaction
+	retval.Entries = v.txTemplateFields.Entries
+	return &retval, nil
+}
+
+// txTemplateFields includes the GraphQL fields of TxTemplate requested by the fragment txTemplateFields.
+type txTemplateFields struct {
+	TxTemplateId string                                           `json:"txTemplateId"`
+	Version      int                                              `json:"version"`
+	Code         string                                           `json:"code"`
+	Description  *string                                          `json:"description"`
+	Params       []txTemplateFieldsParamsParamDefinition          `json:"params"`
+	Transaction  txTemplateFieldsTransactionTxTemplateTransaction `json:"transaction"`
+	Entries      []txTemplateFieldsEntriesTxTemplateEntry         `json:"entries"`
+}
+
+// GetTxTemplateId returns txTemplateFields.TxTemplateId, and is useful for accessing the field via an interface.
+func (v *txTemplateFields) GetTxTemplateId() string { return v.TxTemplateId }
+
+// GetVersion returns txTemplateFields.Version, and is useful for accessing the field via an interface.
+func (v *txTemplateFields) GetVersion() int { return v.Version }
+
+// GetCode returns txTemplateFields.Code, and is useful for accessing the field via an interface.
+func (v *txTemplateFields) GetCode() string { return v.Code }
+
+// GetDescription returns txTemplateFields.Description, and is useful for accessing the field via an interface.
+func (v *txTemplateFields) GetDescription() *string { return v.Description }
+
+// GetParams returns txTemplateFields.Params, and is useful for accessing the field via an interface.
+func (v *txTemplateFields) GetParams() []txTemplateFieldsParamsParamDefinition { return v.Params }
+
+// GetTransaction returns txTemplateFields.Transaction, and is useful for accessing the field via an interface.
+func (v *txTemplateFields) GetTransaction() txTemplateFieldsTransactionTxTemplateTransaction {
+	return v.Transaction
+}
+
+// GetEntries returns txTemplateFields.Entries, and is useful for accessing the field via an interface.
+func (v *txTemplateFields) GetEntries() []txTemplateFieldsEntriesTxTemplateEntry { return v.Entries }
+
+// txTemplateFieldsEntriesTxTemplateEntry includes the requested fields of the GraphQL type TxTemplateEntry.
+type txTemplateFieldsEntriesTxTemplateEntry struct {
+	EntryType   string  `json:"entryType"`
+	AccountId   string  `json:"accountId"`
+	Layer       string  `json:"layer"`
+	Direction   string  `json:"direction"`
+	Units       string  `json:"units"`
+	Currency    string  `json:"currency"`
+	Description *string `json:"description"`
+}
+
+// GetEntryType returns txTemplateFieldsEntriesTxTemplateEntry.EntryType, and is useful for accessing the field via an interface.
+func (v *txTemplateFieldsEntriesTxTemplateEntry) GetEntryType() string { return v.EntryType }
+
+// GetAccountId returns txTemplateFieldsEntriesTxTemplateEntry.AccountId, and is useful for accessing the field via an interface.
+func (v *txTemplateFieldsEntriesTxTemplateEntry) GetAccountId() string { return v.AccountId }
+
+// GetLayer returns txTemplateFieldsEntriesTxTemplateEntry.Layer, and is useful for accessing the field via an interface.
+func (v *txTemplateFieldsEntriesTxTemplateEntry) GetLayer() string { return v.Layer }
+
+// GetDirection returns txTemplateFieldsEntriesTxTemplateEntry.Direction, and is useful for accessing the field via an interface.
+func (v *txTemplateFieldsEntriesTxTemplateEntry) GetDirection() string { return v.Direction }
+
+// GetUnits returns txTemplateFieldsEntriesTxTemplateEntry.Units, and is useful for accessing the field via an interface.
+func (v *txTemplateFieldsEntriesTxTemplateEntry) GetUnits() string { return v.Units }
+
+// GetCurrency returns txTemplateFieldsEntriesTxTemplateEntry.Currency, and is useful for accessing the field via an interface.
+func (v *txTemplateFieldsEntriesTxTemplateEntry) GetCurrency() string { return v.Currency }
+
+// GetDescription returns txTemplateFieldsEntriesTxTemplateEntry.Description, and is useful for accessing the field via an interface.
+func (v *txTemplateFieldsEntriesTxTemplateEntry) GetDescription() *string { return v.Description }
+
+// txTemplateFieldsParamsParamDefinition includes the requested fields of the GraphQL type ParamDefinition.
+type txTemplateFieldsParamsParamDefinition struct {
+	Name        string        `json:"name"`
+	Type        ParamDataType `json:"type"`
+	Default     *string       `json:"default"`
+	Description *string       `json:"description"`
+}
+
+// GetName returns txTemplateFieldsParamsParamDefinition.Name, and is useful for accessing the field via an interface.
+func (v *txTemplateFieldsParamsParamDefinition) GetName() string { return v.Name }
+
+// GetType returns txTemplateFieldsParamsParamDefinition.Type, and is useful for accessing the field via an interface.
+func (v *txTemplateFieldsParamsParamDefinition) GetType() ParamDataType { return v.Type }
+
+// GetDefault returns txTemplateFieldsParamsParamDefinition.Default, and is useful for accessing the field via an interface.
+func (v *txTemplateFieldsParamsParamDefinition) GetDefault() *string { return v.Default }
+
+// GetDescription returns txTemplateFieldsParamsParamDefinition.Description, and is useful for accessing the field via an interface.
+func (v *txTemplateFieldsParamsParamDefinition) GetDescription() *string { return v.Description }
+
+// txTemplateFieldsTransactionTxTemplateTransaction includes the requested fields of the GraphQL type TxTemplateTransaction.
+type txTemplateFieldsTransactionTxTemplateTransaction struct {
+	Effective     string  `json:"effective"`
+	JournalId     string  `json:"journalId"`
+	CorrelationId *string `json:"correlationId"`
+	ExternalId    *string `json:"externalId"`
+	Description   *string `json:"description"`
+	Metadata      *string `json:"metadata"`
+}
+
+// GetEffective returns txTemplateFieldsTransactionTxTemplateTransaction.Effective, and is useful for accessing the field via an interface.
+func (v *txTemplateFieldsTransactionTxTemplateTransaction) GetEffective() string { return v.Effective }
+
+// GetJournalId returns txTemplateFieldsTransactionTxTemplateTransaction.JournalId, and is useful for accessing the field via an interface.
+func (v *txTemplateFieldsTransactionTxTemplateTransaction) GetJournalId() string { return v.JournalId }
+
+// GetCorrelationId returns txTemplateFieldsTransactionTxTemplateTransaction.CorrelationId, and is useful for accessing the field via an interface.
+func (v *txTemplateFieldsTransactionTxTemplateTransaction) GetCorrelationId() *string {
+	return v.CorrelationId
+}
+
+// GetExternalId returns txTemplateFieldsTransactionTxTemplateTransaction.ExternalId, and is useful for accessing the field via an interface.
+func (v *txTemplateFieldsTransactionTxTemplateTransaction) GetExternalId() *string {
+	return v.ExternalId
+}
+
+// GetDescription returns txTemplateFieldsTransactionTxTemplateTransaction.Description, and is useful for accessing the field via an interface.
+func (v *txTemplateFieldsTransactionTxTemplateTransaction) GetDescription() *string {
+	return v.Description
+}
+
+// GetMetadata returns txTemplateFieldsTransactionTxTemplateTransaction.Metadata, and is useful for accessing the field via an interface.
+func (v *txTemplateFieldsTransactionTxTemplateTransaction) GetMetadata() *string { return v.Metadata }
+
+// txTemplateGetByCodeResponse is returned by txTemplateGetByCode on success.
+type txTemplateGetByCodeResponse struct {
+	TxTemplateByCode *txTemplateGetByCodeTxTemplateByCodeTxTemplate `json:"txTemplateByCode"`
+}
+
+// GetTxTemplateByCode returns txTemplateGetByCodeResponse.TxTemplateByCode, and is useful for accessing the field via an interface.
+func (v *txTemplateGetByCodeResponse) GetTxTemplateByCode() *txTemplateGetByCodeTxTemplateByCodeTxTemplate {
+	return v.TxTemplateByCode
+}
+
+// txTemplateGetByCodeTxTemplateByCodeTxTemplate includes the requested fields of the GraphQL type TxTemplate.
+type txTemplateGetByCodeTxTemplateByCodeTxTemplate struct {
+	txTemplateFields `json:"-"`
+}
+
+// GetTxTemplateId returns txTemplateGetByCodeTxTemplateByCodeTxTemplate.TxTemplateId, and is useful for accessing the field via an interface.
+func (v *txTemplateGetByCodeTxTemplateByCodeTxTemplate) GetTxTemplateId() string {
+	return v.txTemplateFields.TxTemplateId
+}
+
+// GetVersion returns txTemplateGetByCodeTxTemplateByCodeTxTemplate.Version, and is useful for accessing the field via an interface.
+func (v *txTemplateGetByCodeTxTemplateByCodeTxTemplate) GetVersion() int {
+	return v.txTemplateFields.Version
+}
+
+// GetCode returns txTemplateGetByCodeTxTemplateByCodeTxTemplate.Code, and is useful for accessing the field via an interface.
+func (v *txTemplateGetByCodeTxTemplateByCodeTxTemplate) GetCode() string {
+	return v.txTemplateFields.Code
+}
+
+// GetDescription returns txTemplateGetByCodeTxTemplateByCodeTxTemplate.Description, and is useful for accessing the field via an interface.
+func (v *txTemplateGetByCodeTxTemplateByCodeTxTemplate) GetDescription() *string {
+	return v.txTemplateFields.Description
+}
+
+// GetParams returns txTemplateGetByCodeTxTemplateByCodeTxTemplate.Params, and is useful for accessing the field via an interface.
+func (v *txTemplateGetByCodeTxTemplateByCodeTxTemplate) GetParams() []txTemplateFieldsParamsParamDefinition {
+	return v.txTemplateFields.Params
+}
+
+// GetTransaction returns txTemplateGetByCodeTxTemplateByCodeTxTemplate.Transaction, and is useful for accessing the field via an interface.
+func (v *txTemplateGetByCodeTxTemplateByCodeTxTemplate) GetTransaction() txTemplateFieldsTransactionTxTemplateTransaction {
+	return v.txTemplateFields.Transaction
+}
+
+// GetEntries returns txTemplateGetByCodeTxTemplateByCodeTxTemplate.Entries, and is useful for accessing the field via an interface.
+func (v *txTemplateGetByCodeTxTemplateByCodeTxTemplate) GetEntries() []txTemplateFieldsEntriesTxTemplateEntry {
+	return v.txTemplateFields.Entries
+}
+
+func (v *txTemplateGetByCodeTxTemplateByCodeTxTemplate) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*txTemplateGetByCodeTxTemplateByCodeTxTemplate
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.txTemplateGetByCodeTxTemplateByCodeTxTemplate = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.txTemplateFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshaltxTemplateGetByCodeTxTemplateByCodeTxTemplate struct {
+	TxTemplateId string `json:"txTemplateId"`
+
+	Version int `json:"version"`
+
+	Code string `json:"code"`
+
+	Description *string `json:"description"`
+
+	Params []txTemplateFieldsParamsParamDefinition `json:"params"`
+
+	Transaction txTemplateFieldsTransactionTxTemplateTransaction `json:"transaction"`
+
+	Entries []txTemplateFieldsEntriesTxTemplateEntry `json:"entries"`
+}
+
+func (v *txTemplateGetByCodeTxTemplateByCodeTxTemplate) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *txTemplateGetByCodeTxTemplateByCodeTxTemplate) __premarshalJSON() (*__premarshaltxTemplateGetByCodeTxTemplateByCodeTxTemplate, error) {
+	var retval __premarshaltxTemplateGetByCodeTxTemplateByCodeTxTemplate
+
+	retval.TxTemplateId = v.txTemplateFields.TxTemplateId
+	retval.Version = v.txTemplateFields.Version
+	retval.Code = v.txTemplateFields.Code
+	retval.Description = v.txTemplateFields.Description
+	retval.Params = v.txTemplateFields.Params
+	retval.Transaction = v.txTemplateFields.Transaction
+	retval.Entries = v.txTemplateFields.Entries
+	return &retval, nil
+}
+
+// txTemplateGetResponse is returned by txTemplateGet on success.
+type txTemplateGetResponse struct {
+	TxTemplate *txTemplateGetTxTemplate `json:"txTemplate"`
+}
+
+// GetTxTemplate returns txTemplateGetResponse.TxTemplate, and is useful for accessing the field via an interface.
+func (v *txTemplateGetResponse) GetTxTemplate() *txTemplateGetTxTemplate { return v.TxTemplate }
+
+// txTemplateGetTxTemplate includes the requested fields of the GraphQL type TxTemplate.
+type txTemplateGetTxTemplate struct {
+	txTemplateFields `json:"-"`
+}
+
+// GetTxTemplateId returns txTemplateGetTxTemplate.TxTemplateId, and is useful for accessing the field via an interface.
+func (v *txTemplateGetTxTemplate) GetTxTemplateId() string { return v.txTemplateFields.TxTemplateId }
+
+// GetVersion returns txTemplateGetTxTemplate.Version, and is useful for accessing the field via an interface.
+func (v *txTemplateGetTxTemplate) GetVersion() int { return v.txTemplateFields.Version }
+
+// GetCode returns txTemplateGetTxTemplate.Code, and is useful for accessing the field via an interface.
+func (v *txTemplateGetTxTemplate) GetCode() string { return v.txTemplateFields.Code }
+
+// GetDescription returns txTemplateGetTxTemplate.Description, and is useful for accessing the field via an interface.
+func (v *txTemplateGetTxTemplate) GetDescription() *string { return v.txTemplateFields.Description }
+
+// GetParams returns txTemplateGetTxTemplate.Params, and is useful for accessing the field via an interface.
+func (v *txTemplateGetTxTemplate) GetParams() []txTemplateFieldsParamsParamDefinition {
+	return v.txTemplateFields.Params
+}
+
+// GetTransaction returns txTemplateGetTxTemplate.Transaction, and is useful for accessing the field via an interface.
+func (v *txTemplateGetTxTemplate) GetTransaction() txTemplateFieldsTransactionTxTemplateTransaction {
+	return v.txTemplateFields.Transaction
+}
+
+// GetEntries returns txTemplateGetTxTemplate.Entries, and is useful for accessing the field via an interface.
+func (v *txTemplateGetTxTemplate) GetEntries() []txTemplateFieldsEntriesTxTemplateEntry {
+	return v.txTemplateFields.Entries
+}
+
+func (v *txTemplateGetTxTemplate) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*txTemplateGetTxTemplate
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.txTemplateGetTxTemplate = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.txTemplateFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshaltxTemplateGetTxTemplate struct {
+	TxTemplateId string `json:"txTemplateId"`
+
+	Version int `json:"version"`
+
+	Code string `json:"code"`
+
+	Description *string `json:"description"`
+
+	Params []txTemplateFieldsParamsParamDefinition `json:"params"`
+
+	Transaction txTemplateFieldsTransactionTxTemplateTransaction `json:"transaction"`
+
+	Entries []txTemplateFieldsEntriesTxTemplateEntry `json:"entries"`
+}
+
+func (v *txTemplateGetTxTemplate) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *txTemplateGetTxTemplate) __premarshalJSON() (*__premarshaltxTemplateGetTxTemplate, error) {
+	var retval __premarshaltxTemplateGetTxTemplate
+
+	retval.TxTemplateId = v.txTemplateFields.TxTemplateId
+	retval.Version = v.txTemplateFields.Version
+	retval.Code = v.txTemplateFields.Code
+	retval.Description = v.txTemplateFields.Description
+	retval.Params = v.txTemplateFields.Params
+	retval.Transaction = v.txTemplateFields.Transaction
+	retval.Entries = v.txTemplateFields.Entries
+	return &retval, nil
+}
+
+// The query or mutation executed by accountBalanceGet.
+const accountBalanceGet_Operation = `
+query accountBalanceGet ($id: UUID!, $journalId: UUID!, $currency: CurrencyCode!) {
+	account(id: $id) {
+		accountId
+		balance(journalId: $journalId, currency: $currency) {
+			... balanceFields
+		}
+	}
+}
+fragment balanceFields on Balance {
+	currency
+	settled {
+		... balanceAmountFields
+	}
+	pending {
+		... balanceAmountFields
+	}
+	encumbrance {
+		... balanceAmountFields
+	}
+	availableSettled: available(layer: SETTLED) {
+		... balanceAmountFields
+	}
+	availablePending: available(layer: PENDING) {
+		... balanceAmountFields
+	}
+	availableEncumbrance: available(layer: ENCUMBRANCE) {
+		... balanceAmountFields
+	}
+}
+fragment balanceAmountFields on BalanceAmount {
+	drBalance {
+		units
+	}
+	crBalance {
+		units
+	}
+	normalBalance {
+		units
+	}
+}
+`
+
+func accountBalanceGet(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+	journalId string,
+	currency string,
+) (*accountBalanceGetResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "accountBalanceGet",
+		Query:  accountBalanceGet_Operation,
+		Variables: &__accountBalanceGetInput{
+			Id:        id,
+			JournalId: journalId,
+			Currency:  currency,
+		},
+	}
+	var err_ error
 
-// journalUpdateResponse is returned by journalUpdate on success.
-type journalUpdateResponse struct {
-	JournalUpdate journalUpdateJournalUpdateJournalUpdatePayload `json:"journalUpdate"`
-}
+	var data_ accountBalanceGetResponse
+	resp_ := &graphql.Response{Data: &data_}
 
-// GetJournalUpdate returns journalUpdateResponse.JournalUpdate, and is useful for accessing the field via an interface.
-func (v *journalUpdateResponse) GetJournalUpdate() journalUpdateJournalUpdateJournalUpdatePayload {
-	return v.JournalUpdate
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
 }
 
 // The query or mutation executed by accountCreate.
@@ -1322,6 +3904,160 @@ func accountGet(
 	return &data_, err_
 }
 
+// The query or mutation executed by accountSetBalanceGet.
+const accountSetBalanceGet_Operation = `
+query accountSetBalanceGet ($id: UUID!, $currency: CurrencyCode!) {
+	accountSet(id: $id) {
+		accountSetId
+		journalId
+		balance(currency: $currency) {
+			... balanceFields
+		}
+	}
+}
+fragment balanceFields on Balance {
+	currency
+	settled {
+		... balanceAmountFields
+	}
+	pending {
+		... balanceAmountFields
+	}
+	encumbrance {
+		... balanceAmountFields
+	}
+	availableSettled: available(layer: SETTLED) {
+		... balanceAmountFields
+	}
+	availablePending: available(layer: PENDING) {
+		... balanceAmountFields
+	}
+	availableEncumbrance: available(layer: ENCUMBRANCE) {
+		... balanceAmountFields
+	}
+}
+fragment balanceAmountFields on BalanceAmount {
+	drBalance {
+		units
+	}
+	crBalance {
+		units
+	}
+	normalBalance {
+		units
+	}
+}
+`
+
+func accountSetBalanceGet(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+	currency string,
+) (*accountSetBalanceGetResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "accountSetBalanceGet",
+		Query:  accountSetBalanceGet_Operation,
+		Variables: &__accountSetBalanceGetInput{
+			Id:       id,
+			Currency: currency,
+		},
+	}
+	var err_ error
+
+	var data_ accountSetBalanceGetResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by accountSetBalanceInRangeGet.
+const accountSetBalanceInRangeGet_Operation = `
+query accountSetBalanceInRangeGet ($id: UUID!, $journalId: UUID!, $currency: CurrencyCode!, $from: Timestamp!, $until: Timestamp) {
+	accountSet(id: $id) {
+		accountSetId
+		journalId
+		balanceInRange(journalId: $journalId, currency: $currency, from: $from, until: $until) {
+			diff {
+				... balanceFields
+			}
+		}
+	}
+}
+fragment balanceFields on Balance {
+	currency
+	settled {
+		... balanceAmountFields
+	}
+	pending {
+		... balanceAmountFields
+	}
+	encumbrance {
+		... balanceAmountFields
+	}
+	availableSettled: available(layer: SETTLED) {
+		... balanceAmountFields
+	}
+	availablePending: available(layer: PENDING) {
+		... balanceAmountFields
+	}
+	availableEncumbrance: available(layer: ENCUMBRANCE) {
+		... balanceAmountFields
+	}
+}
+fragment balanceAmountFields on BalanceAmount {
+	drBalance {
+		units
+	}
+	crBalance {
+		units
+	}
+	normalBalance {
+		units
+	}
+}
+`
+
+func accountSetBalanceInRangeGet(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+	journalId string,
+	currency string,
+	from string,
+	until *string,
+) (*accountSetBalanceInRangeGetResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "accountSetBalanceInRangeGet",
+		Query:  accountSetBalanceInRangeGet_Operation,
+		Variables: &__accountSetBalanceInRangeGetInput{
+			Id:        id,
+			JournalId: journalId,
+			Currency:  currency,
+			From:      from,
+			Until:     until,
+		},
+	}
+	var err_ error
+
+	var data_ accountSetBalanceInRangeGetResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by accountSetCreate.
 const accountSetCreate_Operation = `
 mutation accountSetCreate ($input: AccountSetCreateInput!) {
@@ -1564,6 +4300,62 @@ func accountSetMemberAccountSetRemove(
 	return &data_, err_
 }
 
+// The query or mutation executed by accountSetMembersList.
+const accountSetMembersList_Operation = `
+query accountSetMembersList ($id: UUID!, $first: Int!, $after: String) {
+	accountSet(id: $id) {
+		members(first: $first, after: $after) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			nodes {
+				__typename
+				... on Account {
+					accountId
+					code
+					name
+				}
+				... on AccountSet {
+					accountSetId
+					name
+				}
+			}
+		}
+	}
+}
+`
+
+func accountSetMembersList(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+	first int,
+	after *string,
+) (*accountSetMembersListResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "accountSetMembersList",
+		Query:  accountSetMembersList_Operation,
+		Variables: &__accountSetMembersListInput{
+			Id:    id,
+			First: first,
+			After: after,
+		},
+	}
+	var err_ error
+
+	var data_ accountSetMembersListResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by accountSetUpdate.
 const accountSetUpdate_Operation = `
 mutation accountSetUpdate ($id: UUID!, $input: AccountSetUpdateInput!) {
@@ -1607,6 +4399,100 @@ func accountSetUpdate(
 	return &data_, err_
 }
 
+// The query or mutation executed by accountSetsOfAccount.
+const accountSetsOfAccount_Operation = `
+query accountSetsOfAccount ($id: UUID!, $first: Int!, $after: String) {
+	account(id: $id) {
+		sets(first: $first, after: $after) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			nodes {
+				accountSetId
+			}
+		}
+	}
+}
+`
+
+func accountSetsOfAccount(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+	first int,
+	after *string,
+) (*accountSetsOfAccountResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "accountSetsOfAccount",
+		Query:  accountSetsOfAccount_Operation,
+		Variables: &__accountSetsOfAccountInput{
+			Id:    id,
+			First: first,
+			After: after,
+		},
+	}
+	var err_ error
+
+	var data_ accountSetsOfAccountResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by accountSetsOfAccountSet.
+const accountSetsOfAccountSet_Operation = `
+query accountSetsOfAccountSet ($id: UUID!, $first: Int!, $after: String) {
+	accountSet(id: $id) {
+		sets(first: $first, after: $after) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			nodes {
+				accountSetId
+			}
+		}
+	}
+}
+`
+
+func accountSetsOfAccountSet(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+	first int,
+	after *string,
+) (*accountSetsOfAccountSetResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "accountSetsOfAccountSet",
+		Query:  accountSetsOfAccountSet_Operation,
+		Variables: &__accountSetsOfAccountSetInput{
+			Id:    id,
+			First: first,
+			After: after,
+		},
+	}
+	var err_ error
+
+	var data_ accountSetsOfAccountSetResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by accountUpdate.
 const accountUpdate_Operation = `
 mutation accountUpdate ($id: UUID!, $input: AccountUpdateInput!) {
@@ -1625,23 +4511,146 @@ mutation accountUpdate ($id: UUID!, $input: AccountUpdateInput!) {
 }
 `
 
-func accountUpdate(
+func accountUpdate(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+	input AccountUpdateInput,
+) (*accountUpdateResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "accountUpdate",
+		Query:  accountUpdate_Operation,
+		Variables: &__accountUpdateInput{
+			Id:    id,
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ accountUpdateResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by accountsList.
+const accountsList_Operation = `
+query accountsList ($first: Int!, $after: String) {
+	accounts(first: $first, after: $after) {
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		nodes {
+			accountId
+			code
+			name
+			normalBalanceType
+			status
+			externalId
+			description
+			metadata
+			createdAt
+			modifiedAt
+		}
+	}
+}
+`
+
+func accountsList(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	first int,
+	after *string,
+) (*accountsListResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "accountsList",
+		Query:  accountsList_Operation,
+		Variables: &__accountsListInput{
+			First: first,
+			After: after,
+		},
+	}
+	var err_ error
+
+	var data_ accountsListResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by balanceGet.
+const balanceGet_Operation = `
+query balanceGet ($journalId: UUID!, $accountId: UUID!, $currency: CurrencyCode!) {
+	balance(journalId: $journalId, accountId: $accountId, currency: $currency) {
+		... balanceFields
+	}
+}
+fragment balanceFields on Balance {
+	currency
+	settled {
+		... balanceAmountFields
+	}
+	pending {
+		... balanceAmountFields
+	}
+	encumbrance {
+		... balanceAmountFields
+	}
+	availableSettled: available(layer: SETTLED) {
+		... balanceAmountFields
+	}
+	availablePending: available(layer: PENDING) {
+		... balanceAmountFields
+	}
+	availableEncumbrance: available(layer: ENCUMBRANCE) {
+		... balanceAmountFields
+	}
+}
+fragment balanceAmountFields on BalanceAmount {
+	drBalance {
+		units
+	}
+	crBalance {
+		units
+	}
+	normalBalance {
+		units
+	}
+}
+`
+
+func balanceGet(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	id string,
-	input AccountUpdateInput,
-) (*accountUpdateResponse, error) {
+	journalId string,
+	accountId string,
+	currency string,
+) (*balanceGetResponse, error) {
 	req_ := &graphql.Request{
-		OpName: "accountUpdate",
-		Query:  accountUpdate_Operation,
-		Variables: &__accountUpdateInput{
-			Id:    id,
-			Input: input,
+		OpName: "balanceGet",
+		Query:  balanceGet_Operation,
+		Variables: &__balanceGetInput{
+			JournalId: journalId,
+			AccountId: accountId,
+			Currency:  currency,
 		},
 	}
 	var err_ error
 
-	var data_ accountUpdateResponse
+	var data_ balanceGetResponse
 	resp_ := &graphql.Response{Data: &data_}
 
 	err_ = client_.MakeRequest(
@@ -1703,6 +4712,7 @@ query bfxIntegrationGet ($id: UUID!) {
 			integrationId
 			name
 			description
+			journalId
 			omnibusAccountId
 		}
 	}
@@ -1819,6 +4829,90 @@ func bigQueryIntegrationGet(
 	return &data_, err_
 }
 
+// The query or mutation executed by calaOutboxImportJobCreate.
+const calaOutboxImportJobCreate_Operation = `
+mutation calaOutboxImportJobCreate ($input: CalaOutboxImportJobCreateInput!) {
+	calaOutboxImportJobCreate(input: $input) {
+		job {
+			jobId
+			name
+			description
+		}
+	}
+}
+`
+
+func calaOutboxImportJobCreate(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input CalaOutboxImportJobCreateInput,
+) (*calaOutboxImportJobCreateResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "calaOutboxImportJobCreate",
+		Query:  calaOutboxImportJobCreate_Operation,
+		Variables: &__calaOutboxImportJobCreateInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ calaOutboxImportJobCreateResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by jobsList.
+const jobsList_Operation = `
+query jobsList ($first: Int!, $after: String) {
+	jobs(first: $first, after: $after) {
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		nodes {
+			jobId
+			name
+			description
+		}
+	}
+}
+`
+
+func jobsList(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	first int,
+	after *string,
+) (*jobsListResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "jobsList",
+		Query:  jobsList_Operation,
+		Variables: &__jobsListInput{
+			First: first,
+			After: after,
+		},
+	}
+	var err_ error
+
+	var data_ jobsListResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by journalCreate.
 const journalCreate_Operation = `
 mutation journalCreate ($input: JournalCreateInput!) {
@@ -1938,3 +5032,310 @@ func journalUpdate(
 
 	return &data_, err_
 }
+
+// The query or mutation executed by serverVersionGet.
+const serverVersionGet_Operation = `
+query serverVersionGet {
+	serverVersion
+}
+`
+
+func serverVersionGet(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (*serverVersionGetResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "serverVersionGet",
+		Query:  serverVersionGet_Operation,
+	}
+	var err_ error
+
+	var data_ serverVersionGetResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by transactionGet.
+const transactionGet_Operation = `
+query transactionGet ($id: UUID!) {
+	transaction(id: $id) {
+		transactionId
+		txTemplateId
+		journalId
+		effective
+		correlationId
+		externalId
+		description
+	}
+}
+`
+
+func transactionGet(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (*transactionGetResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "transactionGet",
+		Query:  transactionGet_Operation,
+		Variables: &__transactionGetInput{
+			Id: id,
+		},
+	}
+	var err_ error
+
+	var data_ transactionGetResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by transactionPost.
+const transactionPost_Operation = `
+mutation transactionPost ($input: TransactionInput!) {
+	transactionPost(input: $input) {
+		transaction {
+			transactionId
+			txTemplateId
+			journalId
+			effective
+			correlationId
+			externalId
+			description
+		}
+	}
+}
+`
+
+func transactionPost(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input TransactionInput,
+) (*transactionPostResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "transactionPost",
+		Query:  transactionPost_Operation,
+		Variables: &__transactionPostInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ transactionPostResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by txTemplateCreate.
+const txTemplateCreate_Operation = `
+mutation txTemplateCreate ($input: TxTemplateCreateInput!) {
+	txTemplateCreate(input: $input) {
+		txTemplate {
+			... txTemplateFields
+		}
+	}
+}
+fragment txTemplateFields on TxTemplate {
+	txTemplateId
+	version
+	code
+	description
+	params {
+		name
+		type
+		default
+		description
+	}
+	transaction {
+		effective
+		journalId
+		correlationId
+		externalId
+		description
+		metadata
+	}
+	entries {
+		entryType
+		accountId
+		layer
+		direction
+		units
+		currency
+		description
+	}
+}
+`
+
+func txTemplateCreate(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input TxTemplateCreateInput,
+) (*txTemplateCreateResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "txTemplateCreate",
+		Query:  txTemplateCreate_Operation,
+		Variables: &__txTemplateCreateInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ txTemplateCreateResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by txTemplateGet.
+const txTemplateGet_Operation = `
+query txTemplateGet ($id: UUID!) {
+	txTemplate(id: $id) {
+		... txTemplateFields
+	}
+}
+fragment txTemplateFields on TxTemplate {
+	txTemplateId
+	version
+	code
+	description
+	params {
+		name
+		type
+		default
+		description
+	}
+	transaction {
+		effective
+		journalId
+		correlationId
+		externalId
+		description
+		metadata
+	}
+	entries {
+		entryType
+		accountId
+		layer
+		direction
+		units
+		currency
+		description
+	}
+}
+`
+
+func txTemplateGet(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (*txTemplateGetResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "txTemplateGet",
+		Query:  txTemplateGet_Operation,
+		Variables: &__txTemplateGetInput{
+			Id: id,
+		},
+	}
+	var err_ error
+
+	var data_ txTemplateGetResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by txTemplateGetByCode.
+const txTemplateGetByCode_Operation = `
+query txTemplateGetByCode ($code: String!) {
+	txTemplateByCode(code: $code) {
+		... txTemplateFields
+	}
+}
+fragment txTemplateFields on TxTemplate {
+	txTemplateId
+	version
+	code
+	description
+	params {
+		name
+		type
+		default
+		description
+	}
+	transaction {
+		effective
+		journalId
+		correlationId
+		externalId
+		description
+		metadata
+	}
+	entries {
+		entryType
+		accountId
+		layer
+		direction
+		units
+		currency
+		description
+	}
+}
+`
+
+func txTemplateGetByCode(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	code string,
+) (*txTemplateGetByCodeResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "txTemplateGetByCode",
+		Query:  txTemplateGetByCode_Operation,
+		Variables: &__txTemplateGetByCodeInput{
+			Code: code,
+		},
+	}
+	var err_ error
+
+	var data_ txTemplateGetByCodeResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
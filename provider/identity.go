@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IdIdentityModel is the identity of resources identified by their ID alone.
+type IdIdentityModel struct {
+	Id types.String `tfsdk:"id"`
+}
+
+func idIdentitySchema(description string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       description,
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// setIdentity stores the identity of a resource. identity is nil when
+// Terraform does not support resource identity.
+func setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, value any) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	return identity.Set(ctx, value)
+}
+
+// AccountSetMemberIdentityModel is the identity of the memberships of an
+// account set.
+type AccountSetMemberIdentityModel struct {
+	AccountSetId types.String `tfsdk:"account_set_id"`
+	MemberId     types.String `tfsdk:"member_id"`
+}
+
+func accountSetMemberIdentitySchema(memberDescription string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"account_set_id": identityschema.StringAttribute{
+				Description:       "ID of the account set.",
+				RequiredForImport: true,
+			},
+			"member_id": identityschema.StringAttribute{
+				Description:       memberDescription,
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// IntegrationIdentityModel is the identity of integrations.
+type IntegrationIdentityModel struct {
+	IntegrationId types.String `tfsdk:"integration_id"`
+}
+
+func integrationIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"integration_id": identityschema.StringAttribute{
+				Description:       "ID of the integration.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &AccountListResource{}
+
+func NewAccountListResource() list.ListResource {
+	return &AccountListResource{}
+}
+
+// AccountListResource lists the accounts of the ledger for `terraform query`.
+type AccountListResource struct {
+	client *graphql.Client
+}
+
+type AccountListResourceModel struct {
+	CodePrefix types.String `tfsdk:"code_prefix"`
+	Status     types.String `tfsdk:"status"`
+}
+
+func (l *AccountListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account"
+}
+
+func (l *AccountListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists cala accounts, reading all pages of accounts.",
+		Attributes: map[string]schema.Attribute{
+			"code_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only list accounts whose code starts with this prefix.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only list accounts with this status, `ACTIVE` or `LOCKED`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ACTIVE", "LOCKED"),
+				},
+			},
+		},
+	}
+}
+
+func (l *AccountListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*CalaProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *CalaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	l.client = &providerData.Client
+}
+
+func (l *AccountListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data AccountListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var after *string
+		var count int64
+
+		for {
+			response, err := accountsList(ctx, *l.client, defaultAccountsPageSize, after)
+			if err != nil {
+				var diags diag.Diagnostics
+				diags.AddError("Client Error", fmt.Sprintf("Unable to list accounts, got error: %s", err))
+				push(list.ListResult{Diagnostics: diags})
+				return
+			}
+
+			for _, account := range response.Accounts.Nodes {
+				if !strings.HasPrefix(account.Code, data.CodePrefix.ValueString()) {
+					continue
+				}
+				if !data.Status.IsNull() && string(account.Status) != data.Status.ValueString() {
+					continue
+				}
+
+				result := req.NewListResult(ctx)
+				result.DisplayName = account.Code
+
+				result.Diagnostics.Append(result.Identity.Set(ctx, IdIdentityModel{Id: types.StringValue(account.AccountId)})...)
+
+				if req.IncludeResource {
+					result.Diagnostics.Append(result.Resource.Set(ctx, AccountResourceModel{
+						AccountId:         NewUUIDValue(account.AccountId),
+						Name:              types.StringValue(account.Name),
+						Description:       types.StringPointerValue(account.Description),
+						Code:              types.StringValue(account.Code),
+						NormalBalanceType: types.StringValue(string(account.NormalBalanceType)),
+						Status:            types.StringValue(string(account.Status)),
+						ExternalId:        types.StringPointerValue(account.ExternalId),
+					})...)
+				}
+
+				if !push(result) {
+					return
+				}
+
+				count++
+				if req.Limit > 0 && count >= req.Limit {
+					return
+				}
+			}
+
+			pageInfo := response.Accounts.PageInfo
+			if !pageInfo.HasNextPage || pageInfo.EndCursor == nil {
+				return
+			}
+			after = pageInfo.EndCursor
+		}
+	}
+}
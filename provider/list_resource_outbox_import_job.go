@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &OutboxImportJobListResource{}
+
+func NewOutboxImportJobListResource() list.ListResource {
+	return &OutboxImportJobListResource{}
+}
+
+// OutboxImportJobListResource lists the jobs of the ledger for `terraform query`.
+type OutboxImportJobListResource struct {
+	client *graphql.Client
+}
+
+type OutboxImportJobListResourceModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+}
+
+func (l *OutboxImportJobListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_outbox_import_job"
+}
+
+func (l *OutboxImportJobListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists cala jobs, reading all pages of jobs. The endpoint of a job cannot be read and is " +
+			"left null.",
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only list jobs whose name starts with this prefix.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (l *OutboxImportJobListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*CalaProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *CalaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	l.client = &providerData.Client
+}
+
+func (l *OutboxImportJobListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data OutboxImportJobListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var after *string
+		var count int64
+
+		for {
+			response, err := jobsList(ctx, *l.client, defaultJobsPageSize, after)
+			if err != nil {
+				var diags diag.Diagnostics
+				diags.AddError("Client Error", fmt.Sprintf("Unable to list jobs, got error: %s", err))
+				push(list.ListResult{Diagnostics: diags})
+				return
+			}
+
+			for _, job := range response.Jobs.Nodes {
+				if !strings.HasPrefix(job.Name, data.NamePrefix.ValueString()) {
+					continue
+				}
+
+				result := req.NewListResult(ctx)
+				result.DisplayName = job.Name
+
+				result.Diagnostics.Append(result.Identity.Set(ctx, IdIdentityModel{Id: types.StringValue(job.JobId)})...)
+
+				if req.IncludeResource {
+					result.Diagnostics.Append(result.Resource.Set(ctx, OutboxImportJobResourceModel{
+						JobId:       NewUUIDValue(job.JobId),
+						Name:        types.StringValue(job.Name),
+						Description: types.StringPointerValue(job.Description),
+						Endpoint:    types.StringNull(),
+					})...)
+				}
+
+				if !push(result) {
+					return
+				}
+
+				count++
+				if req.Limit > 0 && count >= req.Limit {
+					return
+				}
+			}
+
+			pageInfo := response.Jobs.PageInfo
+			if !pageInfo.HasNextPage || pageInfo.EndCursor == nil {
+				return
+			}
+			after = pageInfo.EndCursor
+		}
+	}
+}
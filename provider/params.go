@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// paramsToJson converts a dynamic params value into the JSON document expected
+// by TransactionInput. A null value results in a nil message.
+func paramsToJson(params types.Dynamic) (*json.RawMessage, error) {
+	if params.IsNull() || params.IsUnderlyingValueNull() {
+		return nil, nil
+	}
+
+	value, err := attrToInterface(params.UnderlyingValue())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := value.(map[string]interface{}); !ok {
+		return nil, fmt.Errorf("params must be an object, got %T", params.UnderlyingValue())
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	message := json.RawMessage(encoded)
+
+	return &message, nil
+}
+
+// attrToInterface converts a terraform value into its JSON equivalent. Numbers
+// are kept as json.Number so decimals do not lose precision.
+func attrToInterface(value attr.Value) (interface{}, error) {
+	if value == nil || value.IsNull() {
+		return nil, nil
+	}
+
+	if value.IsUnknown() {
+		return nil, fmt.Errorf("value is not known yet")
+	}
+
+	switch v := value.(type) {
+	case types.Dynamic:
+		return attrToInterface(v.UnderlyingValue())
+	case types.String:
+		return v.ValueString(), nil
+	case types.Bool:
+		return v.ValueBool(), nil
+	case types.Int64:
+		return v.ValueInt64(), nil
+	case types.Float64:
+		return json.Number(fmt.Sprintf("%v", v.ValueFloat64())), nil
+	case types.Number:
+		return json.Number(v.ValueBigFloat().Text('f', -1)), nil
+	case types.List:
+		return elementsToInterface(v.Elements())
+	case types.Set:
+		return elementsToInterface(v.Elements())
+	case types.Tuple:
+		return elementsToInterface(v.Elements())
+	case types.Map:
+		return attributesToInterface(v.Elements())
+	case types.Object:
+		return attributesToInterface(v.Attributes())
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", value)
+	}
+}
+
+func elementsToInterface(elements []attr.Value) ([]interface{}, error) {
+	result := make([]interface{}, 0, len(elements))
+
+	for _, element := range elements {
+		value, err := attrToInterface(element)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, value)
+	}
+
+	return result, nil
+}
+
+func attributesToInterface(attributes map[string]attr.Value) (map[string]interface{}, error) {
+	result := make(map[string]interface{}, len(attributes))
+
+	for name, attribute := range attributes {
+		value, err := attrToInterface(attribute)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		result[name] = value
+	}
+
+	return result, nil
+}
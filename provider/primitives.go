@@ -19,7 +19,7 @@ func toStatus(value string) (Status, error) {
 	switch value {
 	case "ACTIVE":
 		return StatusActive, nil
-	case "INACTIVE":
+	case "LOCKED", "INACTIVE":
 		return StatusLocked, nil
 	default:
 		return Status(""), fmt.Errorf("invalid value for Status: %s", value)
@@ -2,14 +2,22 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/Khan/genqlient/graphql"
 )
@@ -20,6 +28,8 @@ var (
 )
 
 var _ provider.Provider = &CalaProvider{}
+var _ provider.ProviderWithFunctions = &CalaProvider{}
+var _ provider.ProviderWithListResources = &CalaProvider{}
 
 type CalaProvider struct {
 	// version is set to the provider version on release, "dev" when the
@@ -29,7 +39,48 @@ type CalaProvider struct {
 }
 
 type CalaProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
+	Endpoint                 types.String      `tfsdk:"endpoint"`
+	CaCertPem                types.String      `tfsdk:"ca_cert_pem"`
+	CaCertFile               types.String      `tfsdk:"ca_cert_file"`
+	ClientCert               types.String      `tfsdk:"client_cert"`
+	ClientKey                types.String      `tfsdk:"client_key"`
+	InsecureSkipVerify       types.Bool        `tfsdk:"insecure_skip_verify"`
+	TlsServerName            types.String      `tfsdk:"tls_server_name"`
+	ProxyUrl                 types.String      `tfsdk:"proxy_url"`
+	Headers                  types.Map         `tfsdk:"headers"`
+	SkipVersionCheck         types.Bool        `tfsdk:"skip_version_check"`
+	DefaultJournalId         UUIDValue         `tfsdk:"default_journal_id"`
+	DefaultCurrency          CurrencyCodeValue `tfsdk:"default_currency"`
+	DefaultNormalBalanceType types.String      `tfsdk:"default_normal_balance_type"`
+	ReadOnly                 types.Bool        `tfsdk:"read_only"`
+	AuditLogPath             types.String      `tfsdk:"audit_log_path"`
+}
+
+// CalaProviderData is handed to every resource and data source on Configure.
+type CalaProviderData struct {
+	Client   graphql.Client
+	Defaults CalaProviderDefaults
+
+	// ConfigUnknown is set while the provider configuration depends on values
+	// that are only known during apply, Client cannot reach the server then.
+	ConfigUnknown bool
+
+	// ReadOnly is set by read_only, resources refuse to create, update or
+	// delete anything.
+	ReadOnly bool
+
+	// AuditLog is set by audit_log_path, resources report the entries that
+	// could not be written to it.
+	AuditLog *auditLog
+}
+
+// CalaProviderDefaults are used by resources and data sources when the
+// corresponding attribute is omitted. They allow each aliased provider to
+// carry the settings of its cala environment.
+type CalaProviderDefaults struct {
+	JournalId         string
+	Currency          string
+	NormalBalanceType string
 }
 
 func (p *CalaProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -39,10 +90,89 @@ func (p *CalaProvider) Metadata(ctx context.Context, req provider.MetadataReques
 
 func (p *CalaProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the accounts, journals, tx templates and transactions of a cala ledger.\n\n" +
+			"## Environment Variables\n\n" +
+			"- `" + envVarName + "` is the endpoint of the cala server when `endpoint` is not set.\n" +
+			"- `" + cryptoCurrenciesEnvVarName + "` lists crypto currency codes accepted in addition to `BTC`, `ETH`, `USDT` " +
+			"and `USDC`, comma separated. Each code may be followed by its number of minor unit digits, e.g. " +
+			"`SOL:9,DOGE:8`, which `decimal_round` and `money_format` need. Currency codes are checked before the " +
+			"provider is configured, so the list cannot be a provider attribute.",
 		Attributes: map[string]schema.Attribute{
 			"endpoint": schema.StringAttribute{
-				MarkdownDescription: "The endpoint for cala server.",
-				Required:            true,
+				MarkdownDescription: "The endpoint for cala server. May also be provided via the `" + envVarName + "` environment variable.",
+				Optional:            true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificate(s) to trust in addition to the system pool when verifying the cala server.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file")),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing PEM encoded CA certificate(s) to trust in addition to the system pool.",
+				Optional:            true,
+			},
+			"client_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate used for mutual TLS.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key")),
+				},
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key for `client_cert`.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert")),
+				},
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Disable verification of the server certificate. Only use this for local development.",
+				Optional:            true,
+			},
+			"tls_server_name": schema.StringAttribute{
+				MarkdownDescription: "Server name used to verify the certificate presented by the cala server, when it differs from the endpoint host.",
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the HTTP(S) proxy used to reach the cala server. Defaults to the `HTTPS_PROXY`/`HTTP_PROXY` environment variables.",
+				Optional:            true,
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "Static HTTP headers added to every request sent to the cala server, e.g. for gateway routing or request tagging. A `User-Agent` with the provider version and a unique `X-Request-Id` are added to each request unless overridden here.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"skip_version_check": schema.BoolAttribute{
+				MarkdownDescription: "Skip checking the cala server version against the range supported by this provider during configuration.",
+				Optional:            true,
+			},
+			"default_journal_id": schema.StringAttribute{
+				CustomType:          UUIDType{},
+				MarkdownDescription: "Journal used by resources and data sources when their `journal_id` is omitted.",
+				Optional:            true,
+			},
+			"default_currency": schema.StringAttribute{
+				CustomType:          CurrencyCodeType{},
+				MarkdownDescription: "Currency used by resources and data sources when their `currency` is omitted. Must be an ISO 4217 code or a crypto currency code, `BTC`, `ETH`, `USDT`, `USDC` or one listed in the comma separated `CALA_CRYPTO_CURRENCIES` environment variable.",
+				Optional:            true,
+			},
+			"default_normal_balance_type": schema.StringAttribute{
+				MarkdownDescription: "Normal balance type (`DEBIT` or `CREDIT`) used by accounts and account sets when their `normal_balance_type` is omitted.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("DEBIT", "CREDIT"),
+				},
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Refuse to send any mutation to the cala server, so that creating, updating or deleting a resource fails before the ledger is changed. Reads and data sources keep working, e.g. to run `terraform plan` against a production ledger.",
+				Optional:            true,
+			},
+			"audit_log_path": schema.StringAttribute{
+				MarkdownDescription: "Path of a file to which one JSON line is appended per mutation sent to the cala server, with the operation, its variables with credentials redacted, the IDs in the response, the time, the Terraform workspace and the provider version. The file is created if needed. Entries that cannot be written are reported as warnings, the mutation having already been sent.",
+				Optional:            true,
 			},
 		},
 	}
@@ -57,6 +187,43 @@ func (p *CalaProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
+	// The configuration can depend on values that are only known during apply,
+	// e.g. an endpoint taken from another resource's output. Let Terraform
+	// defer the resources when it supports it, otherwise keep their prior
+	// state instead of failing the plan.
+	if data.hasUnknownValues() {
+		if req.ClientCapabilities.DeferralAllowed {
+			tflog.Debug(ctx, "provider configuration contains unknown values, deferring")
+
+			resp.Deferred = &provider.Deferred{Reason: provider.DeferredReasonProviderConfigUnknown}
+			return
+		}
+
+		tflog.Debug(ctx, "provider configuration contains unknown values, deferring client configuration")
+
+		resp.Diagnostics.AddWarning(
+			"Provider Configuration Unknown",
+			"The provider configuration depends on values that are not known until apply. Resources are not "+
+				"refreshed and keep their prior state, and data sources cannot be read until the configuration is known.",
+		)
+
+		providerData := &CalaProviderData{
+			Client: unconfiguredClient{},
+			Defaults: CalaProviderDefaults{
+				JournalId:         data.DefaultJournalId.ValueString(),
+				Currency:          data.DefaultCurrency.ValueString(),
+				NormalBalanceType: data.DefaultNormalBalanceType.ValueString(),
+			},
+			ConfigUnknown: true,
+			ReadOnly:      data.ReadOnly.ValueBool(),
+		}
+
+		resp.DataSourceData = providerData
+		resp.ResourceData = providerData
+		resp.ListResourceData = providerData
+		return
+	}
+
 	endpoint := ""
 
 	if !data.Endpoint.IsNull() {
@@ -75,17 +242,149 @@ func (p *CalaProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
+	if err := validateEndpoint(endpoint); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("endpoint"), "Invalid Endpoint", err.Error())
+		return
+	}
+
+	if data.InsecureSkipVerify.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"TLS Verification Disabled",
+			"insecure_skip_verify is set, the certificate presented by the cala server will not be verified. "+
+				"Traffic to the ledger can be intercepted and modified. Never use this setting outside of local development.",
+		)
+	}
+
+	transport, err := newTransport(transportConfig{
+		caCertPem:          data.CaCertPem.ValueString(),
+		caCertFile:         data.CaCertFile.ValueString(),
+		clientCert:         data.ClientCert.ValueString(),
+		clientKey:          data.ClientKey.ValueString(),
+		insecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+		tlsServerName:      data.TlsServerName.ValueString(),
+		proxyUrl:           data.ProxyUrl.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Transport Configuration", fmt.Sprintf("Unable to configure the HTTP transport, got error: %s", err))
+		return
+	}
+
+	headers := map[string]string{}
+
+	if !data.Headers.IsNull() {
+		resp.Diagnostics.Append(data.Headers.ElementsAs(ctx, &headers, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	httpClient := http.Client{
 		Transport: &authedTransport{
-			endpoint: endpoint,
-			wrapped:  http.DefaultTransport,
+			endpoint:  endpoint,
+			userAgent: userAgent(req.TerraformVersion, p.version),
+			headers:   headers,
+			wrapped:   transport,
 		},
 	}
 
 	client := graphql.NewClient(endpoint, &httpClient)
 
-	resp.DataSourceData = &client
-	resp.ResourceData = &client
+	if !data.SkipVersionCheck.ValueBool() {
+		response, err := serverVersionGet(ctx, client)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Check Server Version",
+				fmt.Sprintf("Unable to query the cala server version, got error: %s. "+
+					"Set skip_version_check = true in the provider block to bypass this check.", err),
+			)
+			return
+		}
+
+		tflog.Debug(ctx, "checking cala server version", map[string]interface{}{"server_version": response.ServerVersion})
+
+		resp.Diagnostics.Append(checkServerVersion(response.ServerVersion)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var auditLog *auditLog
+
+	if !data.AuditLogPath.IsNull() {
+		var err error
+		auditLog, err = openAuditLog(data.AuditLogPath.ValueString(), auditContext{
+			endpoint:        endpoint,
+			workspace:       terraformWorkspace(),
+			providerVersion: p.version,
+		})
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("audit_log_path"), "Unable to Open Audit Log", fmt.Sprintf("Unable to open the audit log, got error: %s", err))
+			return
+		}
+
+		client = auditedClient{wrapped: client, log: auditLog}
+	}
+
+	if data.ReadOnly.ValueBool() {
+		tflog.Debug(ctx, "provider is read only, mutations will be refused")
+
+		client = readOnlyClient{wrapped: client}
+	}
+
+	providerData := &CalaProviderData{
+		Client: client,
+		Defaults: CalaProviderDefaults{
+			JournalId:         data.DefaultJournalId.ValueString(),
+			Currency:          data.DefaultCurrency.ValueString(),
+			NormalBalanceType: data.DefaultNormalBalanceType.ValueString(),
+		},
+		ReadOnly: data.ReadOnly.ValueBool(),
+		AuditLog: auditLog,
+	}
+
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
+	resp.ListResourceData = providerData
+}
+
+// hasUnknownValues reports whether any configured value is not yet known.
+func (m CalaProviderModel) hasUnknownValues() bool {
+	return m.Endpoint.IsUnknown() ||
+		m.CaCertPem.IsUnknown() ||
+		m.CaCertFile.IsUnknown() ||
+		m.ClientCert.IsUnknown() ||
+		m.ClientKey.IsUnknown() ||
+		m.InsecureSkipVerify.IsUnknown() ||
+		m.TlsServerName.IsUnknown() ||
+		m.ProxyUrl.IsUnknown() ||
+		m.Headers.IsUnknown() ||
+		m.SkipVersionCheck.IsUnknown() ||
+		m.DefaultJournalId.IsUnknown() ||
+		m.DefaultCurrency.IsUnknown() ||
+		m.DefaultNormalBalanceType.IsUnknown() ||
+		m.ReadOnly.IsUnknown() ||
+		m.AuditLogPath.IsUnknown()
+}
+
+// validateEndpoint checks that the endpoint is an absolute http(s) URL.
+func validateEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("endpoint %q is not a valid URL: %s", endpoint, err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("endpoint %q must use the http or https scheme", endpoint)
+	}
+
+	if u.Host == "" {
+		return fmt.Errorf("endpoint %q is missing a host", endpoint)
+	}
+
+	return nil
 }
 
 func (p *CalaProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -97,11 +396,40 @@ func (p *CalaProvider) Resources(ctx context.Context) []func() resource.Resource
 		NewAccountSetMemberAccountSetResource,
 		NewBigQueryIntegrationResource,
 		NewBitfinexIntegrationResource,
+		NewTransactionResource,
+		NewTxTemplateResource,
+		NewOutboxImportJobResource,
+	}
+}
+
+func (p *CalaProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewAccountListResource,
+		NewOutboxImportJobListResource,
 	}
 }
 
 func (p *CalaProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewServerDataSource,
+		NewTxTemplateDataSource,
+		NewAccountsDataSource,
+		NewAccountSetMembersDataSource,
+		NewAccountSetBalanceDataSource,
+		NewTrialBalanceDataSource,
+	}
+}
+
+func (p *CalaProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewTxTemplateEvaluateFunction,
+		NewUuidFromCodeFunction,
+		NewDecimalAddFunction,
+		NewDecimalSubFunction,
+		NewDecimalCmpFunction,
+		NewDecimalRoundFunction,
+		NewMoneyFormatFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -5,32 +5,50 @@ import (
 	"fmt"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/shopspring/decimal"
 )
 
 var _ resource.Resource = &AccountResource{}
 var _ resource.ResourceWithImportState = &AccountResource{}
+var _ resource.ResourceWithModifyPlan = &AccountResource{}
+var _ resource.ResourceWithIdentity = &AccountResource{}
 
 func NewAccountResource() resource.Resource {
 	return &AccountResource{}
 }
 
 type AccountResource struct {
-	client *graphql.Client
+	client        *graphql.Client
+	defaults      CalaProviderDefaults
+	configUnknown bool
+	readOnly      bool
+	auditLog      *auditLog
 }
 
 type AccountResourceModel struct {
-	AccountId         types.String `tfsdk:"id"`
+	AccountId         UUIDValue    `tfsdk:"id"`
 	Name              types.String `tfsdk:"name"`
 	Description       types.String `tfsdk:"description"`
 	Code              types.String `tfsdk:"code"`
 	NormalBalanceType types.String `tfsdk:"normal_balance_type"`
 	Status            types.String `tfsdk:"status"`
 	ExternalId        types.String `tfsdk:"external_id"`
+
+	ProtectNonZeroBalance []AccountBalanceProtectionModel `tfsdk:"protect_non_zero_balance"`
+}
+
+type AccountBalanceProtectionModel struct {
+	JournalId UUIDValue         `tfsdk:"journal_id"`
+	Currency  CurrencyCodeValue `tfsdk:"currency"`
 }
 
 func (r *AccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -42,6 +60,7 @@ func (r *AccountResource) Schema(ctx context.Context, req resource.SchemaRequest
 		MarkdownDescription: "Cala account.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
+				CustomType:          UUIDType{},
 				MarkdownDescription: "ID of the account.",
 				Required:            true,
 			},
@@ -58,44 +77,255 @@ func (r *AccountResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Required:            true,
 			},
 			"normal_balance_type": schema.StringAttribute{
-				MarkdownDescription: "normalBalanceType",
+				MarkdownDescription: "normalBalanceType. Defaults to the provider's `default_normal_balance_type`.",
 				Optional:            true,
 				Computed:            true,
 			},
 			"status": schema.StringAttribute{
-				MarkdownDescription: "status",
+				MarkdownDescription: "Status of the account, `ACTIVE` or `LOCKED`. Defaults to `ACTIVE`.",
 				Default:             stringdefault.StaticString("ACTIVE"),
+				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ACTIVE", "LOCKED"),
+				},
 			},
 			"external_id": schema.StringAttribute{
 				MarkdownDescription: "externalId",
 				Optional:            true,
 			},
+			"protect_non_zero_balance": schema.ListNestedAttribute{
+				MarkdownDescription: "Balances checked during plan before `normal_balance_type` is changed or the account " +
+					"is locked. The plan fails while any of them is not zero.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"journal_id": schema.StringAttribute{
+							CustomType:          UUIDType{},
+							MarkdownDescription: "Journal of the balance. Defaults to the provider's `default_journal_id`.",
+							Optional:            true,
+						},
+						"currency": schema.StringAttribute{
+							CustomType:          CurrencyCodeType{},
+							MarkdownDescription: "Currency of the balance. Defaults to the provider's `default_currency`.",
+							Optional:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+func (r *AccountResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("ID of the account.")
+}
+
 func (r *AccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*graphql.Client)
+	providerData, ok := req.ProviderData.(*CalaProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *CalaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = &providerData.Client
+	r.configUnknown = providerData.ConfigUnknown
+	r.readOnly = providerData.ReadOnly
+	r.auditLog = providerData.AuditLog
+	r.defaults = providerData.Defaults
+}
+
+func (r *AccountResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to default when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan *AccountResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The default only applies to new accounts, existing and imported ones
+	// keep their normal balance type.
+	if config.NormalBalanceType.IsNull() {
+		if !req.State.Raw.IsNull() {
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("normal_balance_type"), &plan.NormalBalanceType)...)
+		} else if r.defaults.NormalBalanceType != "" {
+			plan.NormalBalanceType = types.StringValue(r.defaults.NormalBalanceType)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+
+	// Only changes to an existing account can affect its balances.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state *AccountResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Balances cannot be read until the provider configuration is known.
+	if r.configUnknown {
+		return
+	}
+
+	resp.Diagnostics.Append(r.checkNonZeroBalance(ctx, state, plan)...)
+}
+
+// checkNonZeroBalance fails the plan when the normal balance type of an
+// account that still holds funds changes or the account gets locked.
+func (r *AccountResource) checkNonZeroBalance(ctx context.Context, state, plan *AccountResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// changes are the risky changes, each reported on its attribute.
+	type change struct {
+		attribute   path.Path
+		description string
+		risk        string
+	}
+
+	var changes []change
+	if !plan.NormalBalanceType.IsUnknown() && !plan.NormalBalanceType.Equal(state.NormalBalanceType) {
+		changes = append(changes, change{
+			attribute:   path.Root("normal_balance_type"),
+			description: fmt.Sprintf("change normal_balance_type from %s to %s", state.NormalBalanceType.ValueString(), plan.NormalBalanceType.ValueString()),
+			risk:        "Changing the normal balance type inverts the sign of every balance of the account.",
+		})
+	}
+	if plan.Status.ValueString() == "LOCKED" && state.Status.ValueString() != "LOCKED" {
+		changes = append(changes, change{
+			attribute:   path.Root("status"),
+			description: "lock the account",
+			risk:        "A locked account cannot post the transactions needed to move the funds.",
+		})
+	}
+
+	if len(changes) == 0 || len(plan.ProtectNonZeroBalance) == 0 {
+		return diags
+	}
+
+	for i, protection := range plan.ProtectNonZeroBalance {
+		if protection.JournalId.IsUnknown() || protection.Currency.IsUnknown() {
+			continue
+		}
+
+		journalId := protection.JournalId.ValueString()
+		if protection.JournalId.IsNull() {
+			if r.defaults.JournalId == "" {
+				diags.AddAttributeError(
+					path.Root("protect_non_zero_balance").AtListIndex(i).AtName("journal_id"),
+					"Missing Journal ID",
+					"Set journal_id on the balance to protect or default_journal_id on the provider.",
+				)
+				continue
+			}
+			journalId = r.defaults.JournalId
+		}
+
+		currency := protection.Currency.ValueString()
+		if protection.Currency.IsNull() {
+			if r.defaults.Currency == "" {
+				diags.AddAttributeError(
+					path.Root("protect_non_zero_balance").AtListIndex(i).AtName("currency"),
+					"Missing Currency",
+					"Set currency on the balance to protect or default_currency on the provider.",
+				)
+				continue
+			}
+			currency = r.defaults.Currency
+		}
+
+		response, err := accountBalanceGet(ctx, *r.client, state.AccountId.ValueString(), journalId, currency)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read account balance, got error: %s", err))
+			continue
+		}
+
+		tflog.Trace(ctx, "checked the balance of a protected account", map[string]interface{}{"journal_id": journalId, "currency": currency})
+
+		if response.Account == nil || response.Account.Balance == nil {
+			continue
+		}
+
+		layer, amount, err := nonZeroBalanceLayer(&response.Account.Balance.balanceFields)
+		if err != nil {
+			diags.AddError("Invalid Balance", fmt.Sprintf("Unable to parse the balance of account %s, got error: %s", state.Code.ValueString(), err))
+			continue
+		}
+
+		if layer == "" {
+			continue
+		}
+
+		for _, change := range changes {
+			diags.AddAttributeError(
+				change.attribute,
+				"Account Holds Funds",
+				fmt.Sprintf("Refusing to %s, account %s has a %s balance of %s %s in journal %s. %s "+
+					"Move the funds to another account first, or remove protect_non_zero_balance[%d] to accept the change.",
+					change.description, state.Code.ValueString(), layer, amount, currency, journalId, change.risk, i),
+			)
+		}
+	}
+
+	return diags
+}
+
+// nonZeroBalanceLayer returns the first layer of a balance whose normal
+// balance is not zero, with its amount, or an empty layer when all are zero.
+func nonZeroBalanceLayer(balance *balanceFields) (string, string, error) {
+	layers := []struct {
+		name   string
+		amount balanceAmountFields
+	}{
+		{"settled", balance.Settled.balanceAmountFields},
+		{"pending", balance.Pending.balanceAmountFields},
+		{"encumbrance", balance.Encumbrance.balanceAmountFields},
+	}
+
+	for _, layer := range layers {
+		amount, err := decimal.NewFromString(layer.amount.NormalBalance.Units)
+		if err != nil {
+			return "", "", err
+		}
+
+		if !amount.IsZero() {
+			return layer.name, amount.String(), nil
+		}
+	}
+
+	return "", "", nil
 }
 
 func (r *AccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("create", "account"))
+		return
+	}
+
+	defer r.auditLog.report(&resp.Diagnostics)
+
 	var data *AccountResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -137,7 +367,7 @@ func (r *AccountResource) Create(ctx context.Context, req resource.CreateRequest
 
 	account := response.AccountCreate.Account
 
-	data.AccountId = types.StringValue(account.AccountId)
+	data.AccountId = NewUUIDValue(account.AccountId)
 	data.Name = types.StringValue(account.Name)
 	data.Code = types.StringValue(account.Code)
 	data.Description = types.StringPointerValue(account.Description)
@@ -146,9 +376,16 @@ func (r *AccountResource) Create(ctx context.Context, req resource.CreateRequest
 	data.Status = types.StringValue(string(account.Status))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, IdIdentityModel{Id: types.StringValue(account.AccountId)})...)
 }
 
 func (r *AccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The prior state is kept while the provider configuration is unknown.
+	if r.configUnknown {
+		tflog.Debug(ctx, "provider configuration is unknown, skipping refresh")
+		return
+	}
+
 	var data *AccountResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -167,7 +404,7 @@ func (r *AccountResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	account := response.Account
 
-	data.AccountId = types.StringValue(account.AccountId)
+	data.AccountId = NewUUIDValue(account.AccountId)
 	data.Description = types.StringPointerValue(account.Description)
 	data.Name = types.StringValue(account.Name)
 	data.Code = types.StringValue(account.Code)
@@ -176,9 +413,17 @@ func (r *AccountResource) Read(ctx context.Context, req resource.ReadRequest, re
 	data.ExternalId = types.StringPointerValue(account.ExternalId)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, IdIdentityModel{Id: types.StringValue(account.AccountId)})...)
 }
 
 func (r *AccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("update", "account"))
+		return
+	}
+
+	defer r.auditLog.report(&resp.Diagnostics)
+
 	var data *AccountResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -193,12 +438,19 @@ func (r *AccountResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	// Prepare the input for the update mutation, only updating the name field
+	status, err := toStatus(data.Status.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Status", fmt.Sprintf("Unable to convert status to Status: %s", err))
+		return
+	}
+
+	// Prepare the input for the update mutation
 	input := AccountUpdateInput{
 		Name:              data.Name.ValueStringPointer(),
 		Description:       data.Description.ValueStringPointer(),
 		Code:              data.Code.ValueStringPointer(),
 		NormalBalanceType: &normalBalanceType,
+		Status:            &status,
 		ExternalId:        data.ExternalId.ValueStringPointer(),
 	}
 
@@ -220,7 +472,7 @@ func (r *AccountResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	account := response.Account
 
-	data.AccountId = types.StringValue(account.AccountId)
+	data.AccountId = NewUUIDValue(account.AccountId)
 	data.Description = types.StringPointerValue(account.Description)
 	data.Name = types.StringValue(account.Name)
 	data.Code = types.StringValue(account.Code)
@@ -229,12 +481,16 @@ func (r *AccountResource) Update(ctx context.Context, req resource.UpdateRequest
 	data.ExternalId = types.StringPointerValue(account.ExternalId)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, IdIdentityModel{Id: types.StringValue(account.AccountId)})...)
 }
 
 func (r *AccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("delete", "account"))
+		return
+	}
 }
 
 func (r *AccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
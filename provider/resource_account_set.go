@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -13,18 +14,24 @@ import (
 
 var _ resource.Resource = &AccountSetResource{}
 var _ resource.ResourceWithImportState = &AccountSetResource{}
+var _ resource.ResourceWithModifyPlan = &AccountSetResource{}
+var _ resource.ResourceWithIdentity = &AccountSetResource{}
 
 func NewAccountSetResource() resource.Resource {
 	return &AccountSetResource{}
 }
 
 type AccountSetResource struct {
-	client *graphql.Client
+	client        *graphql.Client
+	defaults      CalaProviderDefaults
+	configUnknown bool
+	readOnly      bool
+	auditLog      *auditLog
 }
 
 type AccountSetResourceModel struct {
-	AccountSetId      types.String `tfsdk:"id"`
-	JournalId         types.String `tfsdk:"journal_id"`
+	AccountSetId      UUIDValue    `tfsdk:"id"`
+	JournalId         UUIDValue    `tfsdk:"journal_id"`
 	Name              types.String `tfsdk:"name"`
 	Description       types.String `tfsdk:"description"`
 	NormalBalanceType types.String `tfsdk:"normal_balance_type"`
@@ -39,6 +46,7 @@ func (r *AccountSetResource) Schema(ctx context.Context, req resource.SchemaRequ
 		MarkdownDescription: "Cala account set.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
+				CustomType:          UUIDType{},
 				MarkdownDescription: "ID of the account.",
 				Required:            true,
 			},
@@ -47,15 +55,17 @@ func (r *AccountSetResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Required:            true,
 			},
 			"journal_id": schema.StringAttribute{
-				MarkdownDescription: "ID of the journal.",
-				Required:            true,
+				CustomType:          UUIDType{},
+				MarkdownDescription: "ID of the journal. Defaults to the provider's `default_journal_id`.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Description of the account.",
 				Optional:            true,
 			},
 			"normal_balance_type": schema.StringAttribute{
-				MarkdownDescription: "normalBalanceType",
+				MarkdownDescription: "normalBalanceType. Defaults to the provider's `default_normal_balance_type`.",
 				Optional:            true,
 				Computed:            true,
 			},
@@ -63,27 +73,79 @@ func (r *AccountSetResource) Schema(ctx context.Context, req resource.SchemaRequ
 	}
 }
 
+func (r *AccountSetResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("ID of the account set.")
+}
+
 func (r *AccountSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*graphql.Client)
+	providerData, ok := req.ProviderData.(*CalaProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *CalaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = &providerData.Client
+	r.configUnknown = providerData.ConfigUnknown
+	r.readOnly = providerData.ReadOnly
+	r.auditLog = providerData.AuditLog
+	r.defaults = providerData.Defaults
+}
+
+func (r *AccountSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to default when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan *AccountSetResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.JournalId.IsNull() {
+		if !req.State.Raw.IsNull() {
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("journal_id"), &plan.JournalId)...)
+		} else if r.defaults.JournalId != "" {
+			plan.JournalId = NewUUIDValue(r.defaults.JournalId)
+		} else if !r.configUnknown {
+			resp.Diagnostics.AddAttributeError(path.Root("journal_id"), "Missing Journal", "journal_id must be set when the provider has no default_journal_id.")
+			return
+		}
+	}
+
+	if config.NormalBalanceType.IsNull() {
+		if !req.State.Raw.IsNull() {
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("normal_balance_type"), &plan.NormalBalanceType)...)
+		} else if r.defaults.NormalBalanceType != "" {
+			plan.NormalBalanceType = types.StringValue(r.defaults.NormalBalanceType)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 }
 
 func (r *AccountSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("create", "account set"))
+		return
+	}
+
+	defer r.auditLog.report(&resp.Diagnostics)
+
 	var data *AccountSetResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -92,6 +154,11 @@ func (r *AccountSetResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	if data.JournalId.IsUnknown() || data.JournalId.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("journal_id"), "Missing Journal", "journal_id must be set when the provider has no default_journal_id.")
+		return
+	}
+
 	normalBalanceType, err := toDebitOrCredit(data.NormalBalanceType.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid Normal Balance Type", fmt.Sprintf("Unable to convert normal_balance_type to DebitOrCredit: %s", err))
@@ -117,16 +184,23 @@ func (r *AccountSetResource) Create(ctx context.Context, req resource.CreateRequ
 
 	account := response.AccountSetCreate.AccountSet
 
-	data.AccountSetId = types.StringValue(account.AccountSetId)
-	data.JournalId = types.StringValue(account.JournalId)
+	data.AccountSetId = NewUUIDValue(account.AccountSetId)
+	data.JournalId = NewUUIDValue(account.JournalId)
 	data.Name = types.StringValue(account.Name)
 	data.Description = types.StringPointerValue(account.Description)
 	data.NormalBalanceType = types.StringValue(string(account.NormalBalanceType))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, IdIdentityModel{Id: types.StringValue(data.AccountSetId.ValueString())})...)
 }
 
 func (r *AccountSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The prior state is kept while the provider configuration is unknown.
+	if r.configUnknown {
+		tflog.Debug(ctx, "provider configuration is unknown, skipping refresh")
+		return
+	}
+
 	var data *AccountSetResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -145,16 +219,24 @@ func (r *AccountSetResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	accountSet := response.AccountSet
 
-	data.AccountSetId = types.StringValue(accountSet.AccountSetId)
-	data.JournalId = types.StringValue(accountSet.JournalId)
+	data.AccountSetId = NewUUIDValue(accountSet.AccountSetId)
+	data.JournalId = NewUUIDValue(accountSet.JournalId)
 	data.Name = types.StringValue(accountSet.Name)
 	data.Description = types.StringPointerValue(accountSet.Description)
 	data.NormalBalanceType = types.StringValue(string(accountSet.NormalBalanceType))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, IdIdentityModel{Id: types.StringValue(data.AccountSetId.ValueString())})...)
 }
 
 func (r *AccountSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("update", "account set"))
+		return
+	}
+
+	defer r.auditLog.report(&resp.Diagnostics)
+
 	var data *AccountSetResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -192,19 +274,23 @@ func (r *AccountSetResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	accountSet := response.AccountSet
 
-	data.AccountSetId = types.StringValue(accountSet.AccountSetId)
-	data.JournalId = types.StringValue(accountSet.JournalId)
+	data.AccountSetId = NewUUIDValue(accountSet.AccountSetId)
+	data.JournalId = NewUUIDValue(accountSet.JournalId)
 	data.Name = types.StringValue(accountSet.Name)
 	data.Description = types.StringPointerValue(accountSet.Description)
 	data.NormalBalanceType = types.StringValue(string(accountSet.NormalBalanceType))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, IdIdentityModel{Id: types.StringValue(data.AccountSetId.ValueString())})...)
 }
 
 func (r *AccountSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("delete", "account set"))
+		return
+	}
 }
 
 func (r *AccountSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
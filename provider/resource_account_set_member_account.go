@@ -3,8 +3,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -13,19 +15,23 @@ import (
 
 var _ resource.Resource = &AccountSetMemberAccountResource{}
 var _ resource.ResourceWithImportState = &AccountSetMemberAccountResource{}
+var _ resource.ResourceWithIdentity = &AccountSetMemberAccountResource{}
 
 func NewAccountSetMemberAccountResource() resource.Resource {
 	return &AccountSetMemberAccountResource{}
 }
 
 type AccountSetMemberAccountResource struct {
-	client *graphql.Client
+	client        *graphql.Client
+	configUnknown bool
+	readOnly      bool
+	auditLog      *auditLog
 }
 
 type AccountSetMemberAccountResourceModel struct {
 	AccountSetMemberId types.String `tfsdk:"id"`
-	AccountSetId       types.String `tfsdk:"account_set_id"`
-	MemberAccountId    types.String `tfsdk:"member_account_id"`
+	AccountSetId       UUIDValue    `tfsdk:"account_set_id"`
+	MemberAccountId    UUIDValue    `tfsdk:"member_account_id"`
 }
 
 func (r *AccountSetMemberAccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -41,10 +47,12 @@ func (r *AccountSetMemberAccountResource) Schema(ctx context.Context, req resour
 				Computed:            true,
 			},
 			"account_set_id": schema.StringAttribute{
+				CustomType:          UUIDType{},
 				MarkdownDescription: "Id of the AccountSet",
 				Required:            true,
 			},
 			"member_account_id": schema.StringAttribute{
+				CustomType:          UUIDType{},
 				MarkdownDescription: "Id of the member AccountSet",
 				Required:            true,
 			},
@@ -52,27 +60,41 @@ func (r *AccountSetMemberAccountResource) Schema(ctx context.Context, req resour
 	}
 }
 
+func (r *AccountSetMemberAccountResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = accountSetMemberIdentitySchema("ID of the member account.")
+}
+
 func (r *AccountSetMemberAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*graphql.Client)
+	providerData, ok := req.ProviderData.(*CalaProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *CalaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = &providerData.Client
+	r.configUnknown = providerData.ConfigUnknown
+	r.readOnly = providerData.ReadOnly
+	r.auditLog = providerData.AuditLog
 }
 
 func (r *AccountSetMemberAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("create", "account set member"))
+		return
+	}
+
+	defer r.auditLog.report(&resp.Diagnostics)
+
 	var data *AccountSetMemberAccountResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -93,9 +115,19 @@ func (r *AccountSetMemberAccountResource) Create(ctx context.Context, req resour
 	data.AccountSetMemberId = types.StringValue(fmt.Sprintf("account_set/%s/account/%s", data.AccountSetId.ValueString(), data.MemberAccountId.ValueString()))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, AccountSetMemberIdentityModel{
+		AccountSetId: types.StringValue(data.AccountSetId.ValueString()),
+		MemberId:     types.StringValue(data.MemberAccountId.ValueString()),
+	})...)
 }
 
 func (r *AccountSetMemberAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The prior state is kept while the provider configuration is unknown.
+	if r.configUnknown {
+		tflog.Debug(ctx, "provider configuration is unknown, skipping refresh")
+		return
+	}
+
 	var data *AccountSetMemberAccountResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -121,13 +153,27 @@ func (r *AccountSetMemberAccountResource) Read(ctx context.Context, req resource
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, AccountSetMemberIdentityModel{
+		AccountSetId: types.StringValue(data.AccountSetId.ValueString()),
+		MemberId:     types.StringValue(data.MemberAccountId.ValueString()),
+	})...)
 }
 
 func (r *AccountSetMemberAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("update", "account set member"))
+		return
+	}
 }
 
 func (r *AccountSetMemberAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("delete", "account set member"))
+		return
+	}
+
+	defer r.auditLog.report(&resp.Diagnostics)
+
 	var data *AccountSetMemberAccountResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -143,5 +189,41 @@ func (r *AccountSetMemberAccountResource) Delete(ctx context.Context, req resour
 }
 
 func (r *AccountSetMemberAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var accountSetId, memberId string
+
+	if req.ID != "" {
+		var err error
+		accountSetId, memberId, err = parseAccountSetMemberId(req.ID, "account")
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+			return
+		}
+	} else {
+		var identity AccountSetMemberIdentityModel
+
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		accountSetId = identity.AccountSetId.ValueString()
+		memberId = identity.MemberId.ValueString()
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("account_set/%s/account/%s", accountSetId, memberId))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_set_id"), accountSetId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member_account_id"), memberId)...)
+}
+
+// parseAccountSetMemberId splits the ID of a membership,
+// account_set/<account set id>/<kind>/<member id>.
+func parseAccountSetMemberId(id string, kind string) (string, string, error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) != 4 || parts[0] != "account_set" || parts[2] != kind || parts[1] == "" || parts[3] == "" {
+		return "", "", fmt.Errorf("expected an ID of the form account_set/<account set id>/%s/<member id>, got %q", kind, id)
+	}
 
+	return parts[1], parts[3], nil
 }
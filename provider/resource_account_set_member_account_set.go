@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -13,19 +14,23 @@ import (
 
 var _ resource.Resource = &AccountSetMemberAccountSetResource{}
 var _ resource.ResourceWithImportState = &AccountSetMemberAccountSetResource{}
+var _ resource.ResourceWithIdentity = &AccountSetMemberAccountSetResource{}
 
 func NewAccountSetMemberAccountSetResource() resource.Resource {
 	return &AccountSetMemberAccountSetResource{}
 }
 
 type AccountSetMemberAccountSetResource struct {
-	client *graphql.Client
+	client        *graphql.Client
+	configUnknown bool
+	readOnly      bool
+	auditLog      *auditLog
 }
 
 type AccountSetMemberAccountSetResourceModel struct {
 	AccountSetMemberId types.String `tfsdk:"id"`
-	AccountSetId       types.String `tfsdk:"account_set_id"`
-	MemberAccountSetId types.String `tfsdk:"member_account_set_id"`
+	AccountSetId       UUIDValue    `tfsdk:"account_set_id"`
+	MemberAccountSetId UUIDValue    `tfsdk:"member_account_set_id"`
 }
 
 func (r *AccountSetMemberAccountSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -41,10 +46,12 @@ func (r *AccountSetMemberAccountSetResource) Schema(ctx context.Context, req res
 				Computed:            true,
 			},
 			"account_set_id": schema.StringAttribute{
+				CustomType:          UUIDType{},
 				MarkdownDescription: "Id of the AccountSet",
 				Required:            true,
 			},
 			"member_account_set_id": schema.StringAttribute{
+				CustomType:          UUIDType{},
 				MarkdownDescription: "Id of the member AccountSet",
 				Required:            true,
 			},
@@ -52,28 +59,42 @@ func (r *AccountSetMemberAccountSetResource) Schema(ctx context.Context, req res
 	}
 }
 
+func (r *AccountSetMemberAccountSetResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = accountSetMemberIdentitySchema("ID of the member account set.")
+}
+
 func (r *AccountSetMemberAccountSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*graphql.Client)
+	providerData, ok := req.ProviderData.(*CalaProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *CalaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = &providerData.Client
+	r.configUnknown = providerData.ConfigUnknown
+	r.readOnly = providerData.ReadOnly
+	r.auditLog = providerData.AuditLog
 }
 
 // create
 func (r *AccountSetMemberAccountSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("create", "account set member"))
+		return
+	}
+
+	defer r.auditLog.report(&resp.Diagnostics)
+
 	var data *AccountSetMemberAccountSetResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -94,9 +115,19 @@ func (r *AccountSetMemberAccountSetResource) Create(ctx context.Context, req res
 	data.AccountSetMemberId = types.StringValue(fmt.Sprintf("account_set/%s/member_account_set/%s", data.AccountSetId.ValueString(), data.MemberAccountSetId.ValueString()))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, AccountSetMemberIdentityModel{
+		AccountSetId: types.StringValue(data.AccountSetId.ValueString()),
+		MemberId:     types.StringValue(data.MemberAccountSetId.ValueString()),
+	})...)
 }
 
 func (r *AccountSetMemberAccountSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The prior state is kept while the provider configuration is unknown.
+	if r.configUnknown {
+		tflog.Debug(ctx, "provider configuration is unknown, skipping refresh")
+		return
+	}
+
 	var data *AccountSetMemberAccountSetResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -122,12 +153,28 @@ func (r *AccountSetMemberAccountSetResource) Read(ctx context.Context, req resou
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, AccountSetMemberIdentityModel{
+		AccountSetId: types.StringValue(data.AccountSetId.ValueString()),
+		MemberId:     types.StringValue(data.MemberAccountSetId.ValueString()),
+	})...)
 }
 
 func (r *AccountSetMemberAccountSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("update", "account set member"))
+		return
+	}
+
 }
 
 func (r *AccountSetMemberAccountSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("delete", "account set member"))
+		return
+	}
+
+	defer r.auditLog.report(&resp.Diagnostics)
+
 	var data *AccountSetMemberAccountSetResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -144,4 +191,29 @@ func (r *AccountSetMemberAccountSetResource) Delete(ctx context.Context, req res
 }
 
 func (r *AccountSetMemberAccountSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var accountSetId, memberId string
+
+	if req.ID != "" {
+		var err error
+		accountSetId, memberId, err = parseAccountSetMemberId(req.ID, "member_account_set")
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+			return
+		}
+	} else {
+		var identity AccountSetMemberIdentityModel
+
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		accountSetId = identity.AccountSetId.ValueString()
+		memberId = identity.MemberId.ValueString()
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("account_set/%s/member_account_set/%s", accountSetId, memberId))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_set_id"), accountSetId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member_account_set_id"), memberId)...)
 }
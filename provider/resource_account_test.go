@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const testAccountId = "50000000-0000-0000-0000-000000000001"
+
+var testDefaults = CalaProviderDefaults{JournalId: testBalanceJournalId, Currency: "USD"}
+
+func TestToStatus(t *testing.T) {
+	cases := map[string]Status{
+		"ACTIVE":   StatusActive,
+		"LOCKED":   StatusLocked,
+		"INACTIVE": StatusLocked,
+	}
+
+	for value, expected := range cases {
+		if status, err := toStatus(value); err != nil || status != expected {
+			t.Errorf("%s: expected %s, got %s (%v)", value, expected, status, err)
+		}
+	}
+
+	if _, err := toStatus("FROZEN"); err == nil {
+		t.Error("FROZEN: expected an error")
+	}
+}
+
+func testAccount(status string) *AccountResourceModel {
+	return &AccountResourceModel{
+		AccountId:         NewUUIDValue(testAccountId),
+		Name:              types.StringValue("Cash"),
+		Description:       types.StringNull(),
+		Code:              types.StringValue("CASH"),
+		NormalBalanceType: types.StringValue("DEBIT"),
+		Status:            types.StringValue(status),
+		ExternalId:        types.StringNull(),
+	}
+}
+
+// accountJson is an account as returned by the account queries and mutations.
+func accountJson(status string) string {
+	return fmt.Sprintf(`{"accountId": %q, "code": "CASH", "name": "Cash", "normalBalanceType": "DEBIT", "status": %q}`, testAccountId, status)
+}
+
+func TestAccountLocked(t *testing.T) {
+	ctx := context.Background()
+
+	var statuses []Status
+	client := &fakeClient{respond: func(req *graphql.Request) (string, bool) {
+		switch variables := req.Variables.(type) {
+		case *__accountCreateInput:
+			statuses = append(statuses, variables.Input.Status)
+			return `{"accountCreate": {"account": ` + accountJson(string(variables.Input.Status)) + `}}`, true
+		case *__accountUpdateInput:
+			statuses = append(statuses, *variables.Input.Status)
+			return `{"accountUpdate": {"account": ` + accountJson(string(*variables.Input.Status)) + `}}`, true
+		case *__accountGetInput:
+			return `{"account": ` + accountJson(string(statuses[len(statuses)-1])) + `}`, true
+		}
+		return "", false
+	}}
+	var graphqlClient graphql.Client = client
+	r := &AccountResource{client: &graphqlClient}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	schema := schemaResp.Schema
+	null := tftypes.NewValue(schema.Type().TerraformType(ctx), nil)
+
+	// An account can be created locked.
+	createReq := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schema, Raw: null}}
+	if diags := createReq.Plan.Set(ctx, testAccount("LOCKED")); diags.HasError() {
+		t.Fatal(diags)
+	}
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: schema, Raw: null}}
+
+	r.Create(ctx, createReq, createResp)
+
+	if createResp.Diagnostics.HasError() {
+		t.Fatal(createResp.Diagnostics)
+	}
+
+	var status types.String
+	createResp.State.GetAttribute(ctx, path.Root("status"), &status)
+	if status.ValueString() != "LOCKED" {
+		t.Errorf("expected the account to be created LOCKED, got %s", status)
+	}
+
+	// An active account can be locked.
+	updateReq := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: schema, Raw: null},
+		State: tfsdk.State{Schema: schema, Raw: null},
+	}
+	diags := updateReq.Plan.Set(ctx, testAccount("LOCKED"))
+	diags.Append(updateReq.State.Set(ctx, testAccount("ACTIVE"))...)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	updateResp := &resource.UpdateResponse{State: tfsdk.State{Schema: schema, Raw: null}}
+
+	r.Update(ctx, updateReq, updateResp)
+
+	if updateResp.Diagnostics.HasError() {
+		t.Fatal(updateResp.Diagnostics)
+	}
+
+	updateResp.State.GetAttribute(ctx, path.Root("status"), &status)
+	if status.ValueString() != "LOCKED" {
+		t.Errorf("expected the account to be updated to LOCKED, got %s", status)
+	}
+
+	if len(statuses) != 2 || statuses[0] != StatusLocked || statuses[1] != StatusLocked {
+		t.Errorf("expected LOCKED to be sent on create and update, got %v", statuses)
+	}
+}
+
+// layeredBalanceJson is a balance with the given normal balance on each of
+// the settled, pending and encumbrance layers.
+func layeredBalanceJson(settled, pending, encumbrance string) string {
+	amount := func(normal string) string {
+		return fmt.Sprintf(`{"drBalance": {"units": "0"}, "crBalance": {"units": "0"}, "normalBalance": {"units": %q}}`, normal)
+	}
+	return fmt.Sprintf(`{"currency": "USD", "settled": %s, "pending": %s, "encumbrance": %s, `+
+		`"availableSettled": %[1]s, "availablePending": %[2]s, "availableEncumbrance": %[3]s}`,
+		amount(settled), amount(pending), amount(encumbrance))
+}
+
+func TestNonZeroBalanceLayer(t *testing.T) {
+	cases := []struct {
+		settled, pending, encumbrance string
+		layer, amount                 string
+		err                           bool
+	}{
+		{"0", "0", "0", "", "", false},
+		{"0.00", "0", "-0", "", "", false},
+		{"100.50", "0", "0", "settled", "100.5", false},
+		{"0", "-20", "5", "pending", "-20", false},
+		{"0", "0", "0.01", "encumbrance", "0.01", false},
+		{"0", "ten", "0", "", "", true},
+	}
+
+	for _, c := range cases {
+		var balance balanceFields
+		if err := json.Unmarshal([]byte(layeredBalanceJson(c.settled, c.pending, c.encumbrance)), &balance); err != nil {
+			t.Fatal(err)
+		}
+
+		layer, amount, err := nonZeroBalanceLayer(&balance)
+		name := strings.Join([]string{c.settled, c.pending, c.encumbrance}, "/")
+
+		if c.err {
+			if err == nil {
+				t.Errorf("%s: expected an error", name)
+			}
+			continue
+		}
+
+		if err != nil || layer != c.layer || amount != c.amount {
+			t.Errorf("%s: expected %q %q, got %q %q (%v)", name, c.layer, c.amount, layer, amount, err)
+		}
+	}
+}
+
+func TestCheckNonZeroBalance(t *testing.T) {
+	ctx := context.Background()
+
+	protected := func(status, normalBalanceType string) *AccountResourceModel {
+		account := testAccount(status)
+		account.NormalBalanceType = types.StringValue(normalBalanceType)
+		account.ProtectNonZeroBalance = []AccountBalanceProtectionModel{{JournalId: NewUUIDNull(), Currency: NewCurrencyCodeNull()}}
+		return account
+	}
+
+	cases := []struct {
+		name       string
+		state      *AccountResourceModel
+		plan       *AccountResourceModel
+		balance    string
+		defaults   CalaProviderDefaults
+		attributes []string
+		requests   int
+	}{
+		{"zero balance locked", protected("ACTIVE", "DEBIT"), protected("LOCKED", "DEBIT"), layeredBalanceJson("0", "0", "0"), testDefaults, nil, 1},
+		{"settled balance locked", protected("ACTIVE", "DEBIT"), protected("LOCKED", "DEBIT"), layeredBalanceJson("10", "0", "0"), testDefaults, []string{"status"}, 1},
+		{"pending balance locked", protected("ACTIVE", "DEBIT"), protected("LOCKED", "DEBIT"), layeredBalanceJson("0", "10", "0"), testDefaults, []string{"status"}, 1},
+		{"encumbrance balance inverted", protected("ACTIVE", "DEBIT"), protected("ACTIVE", "CREDIT"), layeredBalanceJson("0", "0", "10"), testDefaults, []string{"normal_balance_type"}, 1},
+		{"balance locked and inverted", protected("ACTIVE", "DEBIT"), protected("LOCKED", "CREDIT"), layeredBalanceJson("10", "0", "0"), testDefaults, []string{"normal_balance_type", "status"}, 1},
+		{"no balance", protected("ACTIVE", "DEBIT"), protected("LOCKED", "DEBIT"), "null", testDefaults, nil, 1},
+		{"already locked", protected("LOCKED", "DEBIT"), protected("LOCKED", "DEBIT"), layeredBalanceJson("10", "0", "0"), testDefaults, nil, 0},
+		{"unlocked", protected("LOCKED", "DEBIT"), protected("ACTIVE", "DEBIT"), layeredBalanceJson("10", "0", "0"), testDefaults, nil, 0},
+		{"unprotected", testAccount("ACTIVE"), testAccount("LOCKED"), layeredBalanceJson("10", "0", "0"), testDefaults, nil, 0},
+		{"missing defaults", protected("ACTIVE", "DEBIT"), protected("LOCKED", "DEBIT"), layeredBalanceJson("10", "0", "0"), CalaProviderDefaults{}, []string{"protect_non_zero_balance[0].journal_id"}, 0},
+	}
+
+	for _, c := range cases {
+		client := &fakeClient{responses: map[string]string{
+			"accountBalanceGet": `{"account": {"accountId": "` + testAccountId + `", "balance": ` + c.balance + `}}`,
+		}}
+		var graphqlClient graphql.Client = client
+		r := &AccountResource{client: &graphqlClient, defaults: c.defaults}
+
+		diags := r.checkNonZeroBalance(ctx, c.state, c.plan)
+
+		var attributes []string
+		for _, d := range diags {
+			if d, ok := d.(interface{ Path() path.Path }); ok {
+				attributes = append(attributes, d.Path().String())
+			}
+		}
+
+		if strings.Join(attributes, ",") != strings.Join(c.attributes, ",") || len(diags) != len(c.attributes) {
+			t.Errorf("%s: expected errors on %v, got %v", c.name, c.attributes, diags)
+		}
+
+		if len(client.requests) != c.requests {
+			t.Errorf("%s: expected %d balance reads, got %d", c.name, c.requests, len(client.requests))
+		}
+
+		if c.requests > 0 {
+			variables := client.requests[0].Variables.(*__accountBalanceGetInput)
+			if variables.JournalId != testDefaults.JournalId || variables.Currency != testDefaults.Currency {
+				t.Errorf("%s: expected the default journal and currency to be read, got %+v", c.name, variables)
+			}
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -13,17 +14,21 @@ import (
 
 var _ resource.Resource = &BigQueryIntegrationResource{}
 var _ resource.ResourceWithImportState = &BigQueryIntegrationResource{}
+var _ resource.ResourceWithIdentity = &BigQueryIntegrationResource{}
 
 func NewBigQueryIntegrationResource() resource.Resource {
 	return &BigQueryIntegrationResource{}
 }
 
 type BigQueryIntegrationResource struct {
-	client *graphql.Client
+	client        *graphql.Client
+	configUnknown bool
+	readOnly      bool
+	auditLog      *auditLog
 }
 
 type BigQueryIntegrationResourceModel struct {
-	BigQueryIntegrationId     types.String `tfsdk:"id"`
+	BigQueryIntegrationId     UUIDValue    `tfsdk:"id"`
 	Name                      types.String `tfsdk:"name"`
 	Description               types.String `tfsdk:"description"`
 	ServiceAccountCredsBase64 types.String `tfsdk:"service_account_creds_base64"`
@@ -40,6 +45,7 @@ func (r *BigQueryIntegrationResource) Schema(ctx context.Context, req resource.S
 		MarkdownDescription: "Cala BigQuery Integration.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
+				CustomType:          UUIDType{},
 				MarkdownDescription: "ID of the integration.",
 				Required:            true,
 			},
@@ -68,27 +74,41 @@ func (r *BigQueryIntegrationResource) Schema(ctx context.Context, req resource.S
 	}
 }
 
+func (r *BigQueryIntegrationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = integrationIdentitySchema()
+}
+
 func (r *BigQueryIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*graphql.Client)
+	providerData, ok := req.ProviderData.(*CalaProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *CalaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = &providerData.Client
+	r.configUnknown = providerData.ConfigUnknown
+	r.readOnly = providerData.ReadOnly
+	r.auditLog = providerData.AuditLog
 }
 
 func (r *BigQueryIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("create", "integration"))
+		return
+	}
+
+	defer r.auditLog.report(&resp.Diagnostics)
+
 	var data *BigQueryIntegrationResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -117,16 +137,23 @@ func (r *BigQueryIntegrationResource) Create(ctx context.Context, req resource.C
 
 	integration := response.BigQuery.IntegrationCreate.Integration
 
-	data.BigQueryIntegrationId = types.StringValue(integration.IntegrationId)
+	data.BigQueryIntegrationId = NewUUIDValue(integration.IntegrationId)
 	data.Name = types.StringValue(integration.Name)
 	data.Description = types.StringPointerValue(integration.Description)
 	data.ProjectId = types.StringValue(integration.GcpProjectId)
 	data.DatasetId = types.StringValue(integration.GcpDatasetId)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, IntegrationIdentityModel{IntegrationId: types.StringValue(data.BigQueryIntegrationId.ValueString())})...)
 }
 
 func (r *BigQueryIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The prior state is kept while the provider configuration is unknown.
+	if r.configUnknown {
+		tflog.Debug(ctx, "provider configuration is unknown, skipping refresh")
+		return
+	}
+
 	var data *BigQueryIntegrationResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -140,23 +167,30 @@ func (r *BigQueryIntegrationResource) Read(ctx context.Context, req resource.Rea
 
 	integration := response.BigQuery.Integration
 
-	data.BigQueryIntegrationId = types.StringValue(integration.IntegrationId)
+	data.BigQueryIntegrationId = NewUUIDValue(integration.IntegrationId)
 	data.Name = types.StringValue(integration.Name)
 	data.Description = types.StringPointerValue(integration.Description)
 	data.ProjectId = types.StringValue(integration.GcpProjectId)
 	data.DatasetId = types.StringValue(integration.GcpDatasetId)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, IntegrationIdentityModel{IntegrationId: types.StringValue(data.BigQueryIntegrationId.ValueString())})...)
 }
 
 func (r *BigQueryIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("update", "integration"))
+		return
+	}
 }
 
 func (r *BigQueryIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("delete", "integration"))
+		return
+	}
 }
 
 func (r *BigQueryIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("integration_id"), req, resp)
 }
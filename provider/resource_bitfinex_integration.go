@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -13,23 +14,27 @@ import (
 
 var _ resource.Resource = &BitfinexIntegrationResource{}
 var _ resource.ResourceWithImportState = &BitfinexIntegrationResource{}
+var _ resource.ResourceWithIdentity = &BitfinexIntegrationResource{}
 
 func NewBitfinexIntegrationResource() resource.Resource {
 	return &BitfinexIntegrationResource{}
 }
 
 type BitfinexIntegrationResource struct {
-	client *graphql.Client
+	client        *graphql.Client
+	configUnknown bool
+	readOnly      bool
+	auditLog      *auditLog
 }
 
 type BitfinexIntegrationResourceModel struct {
-	BitfinexIntegrationId types.String `tfsdk:"id"`
+	BitfinexIntegrationId UUIDValue    `tfsdk:"id"`
 	Name                  types.String `tfsdk:"name"`
 	Description           types.String `tfsdk:"description"`
-	JournalId             types.String `tfsdk:"journal_id"`
+	JournalId             UUIDValue    `tfsdk:"journal_id"`
 	Key                   types.String `tfsdk:"key"`
 	Secret                types.String `tfsdk:"secret"`
-	OmnibusAccountId      types.String `tfsdk:"omnibus_account_id"`
+	OmnibusAccountId      UUIDValue    `tfsdk:"omnibus_account_id"`
 }
 
 func (r *BitfinexIntegrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -41,6 +46,7 @@ func (r *BitfinexIntegrationResource) Schema(ctx context.Context, req resource.S
 		MarkdownDescription: "Cala Bitfinex Integration.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
+				CustomType:          UUIDType{},
 				MarkdownDescription: "ID of the integration.",
 				Required:            true,
 			},
@@ -53,6 +59,7 @@ func (r *BitfinexIntegrationResource) Schema(ctx context.Context, req resource.S
 				Optional:            true,
 			},
 			"journal_id": schema.StringAttribute{
+				CustomType:          UUIDType{},
 				MarkdownDescription: "journal_id",
 				Required:            true,
 			},
@@ -67,6 +74,7 @@ func (r *BitfinexIntegrationResource) Schema(ctx context.Context, req resource.S
 				Sensitive:           true,
 			},
 			"omnibus_account_id": schema.StringAttribute{
+				CustomType:          UUIDType{},
 				MarkdownDescription: "The Account id for the omnibus Account",
 				Computed:            true,
 			},
@@ -74,27 +82,41 @@ func (r *BitfinexIntegrationResource) Schema(ctx context.Context, req resource.S
 	}
 }
 
+func (r *BitfinexIntegrationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = integrationIdentitySchema()
+}
+
 func (r *BitfinexIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*graphql.Client)
+	providerData, ok := req.ProviderData.(*CalaProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *CalaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = &providerData.Client
+	r.configUnknown = providerData.ConfigUnknown
+	r.readOnly = providerData.ReadOnly
+	r.auditLog = providerData.AuditLog
 }
 
 func (r *BitfinexIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("create", "integration"))
+		return
+	}
+
+	defer r.auditLog.report(&resp.Diagnostics)
+
 	var data *BitfinexIntegrationResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -123,15 +145,22 @@ func (r *BitfinexIntegrationResource) Create(ctx context.Context, req resource.C
 
 	integration := response.Bitfinex.IntegrationCreate.Integration
 
-	data.BitfinexIntegrationId = types.StringValue(integration.IntegrationId)
+	data.BitfinexIntegrationId = NewUUIDValue(integration.IntegrationId)
 	data.Name = types.StringValue(integration.Name)
 	data.Description = types.StringPointerValue(integration.Description)
-	data.OmnibusAccountId = types.StringValue(integration.OmnibusAccountId)
+	data.OmnibusAccountId = NewUUIDValue(integration.OmnibusAccountId)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, IntegrationIdentityModel{IntegrationId: types.StringValue(data.BitfinexIntegrationId.ValueString())})...)
 }
 
 func (r *BitfinexIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The prior state is kept while the provider configuration is unknown.
+	if r.configUnknown {
+		tflog.Debug(ctx, "provider configuration is unknown, skipping refresh")
+		return
+	}
+
 	var data *BitfinexIntegrationResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -145,22 +174,29 @@ func (r *BitfinexIntegrationResource) Read(ctx context.Context, req resource.Rea
 
 	integration := response.Bitfinex.Integration
 
-	data.BitfinexIntegrationId = types.StringValue(integration.IntegrationId)
+	data.BitfinexIntegrationId = NewUUIDValue(integration.IntegrationId)
 	data.Name = types.StringValue(integration.Name)
 	data.Description = types.StringPointerValue(integration.Description)
-	data.OmnibusAccountId = types.StringValue(integration.OmnibusAccountId)
+	data.OmnibusAccountId = NewUUIDValue(integration.OmnibusAccountId)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, IntegrationIdentityModel{IntegrationId: types.StringValue(data.BitfinexIntegrationId.ValueString())})...)
 }
 
 func (r *BitfinexIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("update", "integration"))
+		return
+	}
 }
 
 func (r *BitfinexIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("delete", "integration"))
+		return
+	}
 }
 
 func (r *BitfinexIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("integration_id"), req, resp)
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -14,17 +15,21 @@ import (
 
 var _ resource.Resource = &JournalResource{}
 var _ resource.ResourceWithImportState = &JournalResource{}
+var _ resource.ResourceWithIdentity = &JournalResource{}
 
 func NewJournalResource() resource.Resource {
 	return &JournalResource{}
 }
 
 type JournalResource struct {
-	client *graphql.Client
+	client        *graphql.Client
+	configUnknown bool
+	readOnly      bool
+	auditLog      *auditLog
 }
 
 type JournalResourceModel struct {
-	JournalId   types.String `tfsdk:"id"`
+	JournalId   UUIDValue    `tfsdk:"id"`
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
 	Status      types.String `tfsdk:"status"`
@@ -39,6 +44,7 @@ func (r *JournalResource) Schema(ctx context.Context, req resource.SchemaRequest
 		MarkdownDescription: "Cala journal.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
+				CustomType:          UUIDType{},
 				MarkdownDescription: "ID of the journal.",
 				Required:            true,
 			},
@@ -59,27 +65,41 @@ func (r *JournalResource) Schema(ctx context.Context, req resource.SchemaRequest
 	}
 }
 
+func (r *JournalResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("ID of the journal.")
+}
+
 func (r *JournalResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*graphql.Client)
+	providerData, ok := req.ProviderData.(*CalaProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *CalaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = &providerData.Client
+	r.configUnknown = providerData.ConfigUnknown
+	r.readOnly = providerData.ReadOnly
+	r.auditLog = providerData.AuditLog
 }
 
 func (r *JournalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("create", "journal"))
+		return
+	}
+
+	defer r.auditLog.report(&resp.Diagnostics)
+
 	var data *JournalResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -112,13 +132,20 @@ func (r *JournalResource) Create(ctx context.Context, req resource.CreateRequest
 
 	journal := response.JournalCreate.Journal
 
-	data.JournalId = types.StringValue(journal.JournalId)
+	data.JournalId = NewUUIDValue(journal.JournalId)
 	data.Name = types.StringValue(journal.Name)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, IdIdentityModel{Id: types.StringValue(data.JournalId.ValueString())})...)
 }
 
 func (r *JournalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The prior state is kept while the provider configuration is unknown.
+	if r.configUnknown {
+		tflog.Debug(ctx, "provider configuration is unknown, skipping refresh")
+		return
+	}
+
 	var data *JournalResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -138,15 +165,23 @@ func (r *JournalResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	journal := response.Journal
 
-	data.JournalId = types.StringValue(journal.JournalId)
+	data.JournalId = NewUUIDValue(journal.JournalId)
 	data.Name = types.StringValue(journal.Name)
 	data.Description = types.StringPointerValue(journal.Description)
 	data.Status = types.StringValue(string(journal.Status))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, IdIdentityModel{Id: types.StringValue(data.JournalId.ValueString())})...)
 }
 
 func (r *JournalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("update", "journal"))
+		return
+	}
+
+	defer r.auditLog.report(&resp.Diagnostics)
+
 	var data *JournalResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -178,18 +213,22 @@ func (r *JournalResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	journal := response.Journal
 
-	data.JournalId = types.StringValue(journal.JournalId)
+	data.JournalId = NewUUIDValue(journal.JournalId)
 	data.Name = types.StringValue(journal.Name)
 	data.Description = types.StringPointerValue(journal.Description)
 	data.Status = types.StringValue(string(journal.Status))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, IdIdentityModel{Id: types.StringValue(data.JournalId.ValueString())})...)
 }
 
 func (r *JournalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("delete", "journal"))
+		return
+	}
 }
 
 func (r *JournalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -0,0 +1,282 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &OutboxImportJobResource{}
+var _ resource.ResourceWithImportState = &OutboxImportJobResource{}
+var _ resource.ResourceWithIdentity = &OutboxImportJobResource{}
+var _ resource.ResourceWithModifyPlan = &OutboxImportJobResource{}
+
+const defaultJobsPageSize = 100
+
+func NewOutboxImportJobResource() resource.Resource {
+	return &OutboxImportJobResource{}
+}
+
+type OutboxImportJobResource struct {
+	client        *graphql.Client
+	configUnknown bool
+	readOnly      bool
+	auditLog      *auditLog
+}
+
+type OutboxImportJobResourceModel struct {
+	JobId       UUIDValue    `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Endpoint    types.String `tfsdk:"endpoint"`
+}
+
+func (r *OutboxImportJobResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_outbox_import_job"
+}
+
+func (r *OutboxImportJobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Cala job importing the outbox of another cala server. Jobs cannot be updated, changing an " +
+			"attribute replaces the job. Jobs cannot be deleted either, destroying the resource only removes it from the " +
+			"Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				CustomType:          UUIDType{},
+				MarkdownDescription: "ID of the job.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the job.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the job.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "Outbox endpoint the job imports from, required to create a job. It cannot be read " +
+					"back, so it is null after an import, where it can be omitted, and setting it then does not replace the job.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.StateValue.IsNull() && !req.ConfigValue.IsNull()
+						},
+						"Changing the endpoint replaces the job, unless it is unknown after an import or omitted.",
+						"Changing the endpoint replaces the job, unless it is unknown after an import or omitted.",
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *OutboxImportJobResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("ID of the job.")
+}
+
+func (r *OutboxImportJobResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*CalaProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *CalaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &providerData.Client
+	r.configUnknown = providerData.ConfigUnknown
+	r.readOnly = providerData.ReadOnly
+	r.auditLog = providerData.AuditLog
+}
+
+func (r *OutboxImportJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("create", "job"))
+		return
+	}
+
+	defer r.auditLog.report(&resp.Diagnostics)
+
+	var data *OutboxImportJobResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := CalaOutboxImportJobCreateInput{
+		JobId:       data.JobId.ValueString(),
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		Endpoint:    data.Endpoint.ValueString(),
+	}
+
+	response, err := calaOutboxImportJobCreate(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create job, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a job")
+
+	job := response.CalaOutboxImportJobCreate.Job
+
+	data.JobId = NewUUIDValue(job.JobId)
+	data.Name = types.StringValue(job.Name)
+	data.Description = types.StringPointerValue(job.Description)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, IdIdentityModel{Id: types.StringValue(job.JobId)})...)
+}
+
+func (r *OutboxImportJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The prior state is kept while the provider configuration is unknown.
+	if r.configUnknown {
+		tflog.Debug(ctx, "provider configuration is unknown, skipping refresh")
+		return
+	}
+
+	var data *OutboxImportJobResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job, err := findJob(ctx, *r.client, data.JobId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read job, got error: %s", err))
+		return
+	}
+
+	if job == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.JobId = NewUUIDValue(job.JobId)
+	data.Name = types.StringValue(job.Name)
+	data.Description = types.StringPointerValue(job.Description)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, IdIdentityModel{Id: types.StringValue(job.JobId)})...)
+}
+
+// ModifyPlan keeps the endpoint of the state when it is omitted, which is only
+// allowed once the job exists.
+func (r *OutboxImportJobResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var endpoint types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("endpoint"), &endpoint)...)
+
+	if resp.Diagnostics.HasError() || !endpoint.IsNull() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("endpoint"), "Missing Endpoint", "The endpoint is required to create a job.")
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("endpoint"), &endpoint)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("endpoint"), endpoint)...)
+}
+
+// Update is only reached when the endpoint is set after an import, which
+// does not change the job.
+func (r *OutboxImportJobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("update", "job"))
+		return
+	}
+
+	var data *OutboxImportJobResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, IdIdentityModel{Id: types.StringValue(data.JobId.ValueString())})...)
+}
+
+func (r *OutboxImportJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("delete", "job"))
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Job Not Deleted",
+		"Jobs cannot be deleted. The job was removed from the Terraform state but keeps importing the outbox on the cala server.",
+	)
+}
+
+func (r *OutboxImportJobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// findJob pages through the jobs as cala has no query for a single job. It
+// returns nil when no job has the ID. IDs are compared ignoring case, as
+// imported IDs may not be in the lowercase form cala returns.
+func findJob(ctx context.Context, client graphql.Client, jobId string) (*jobsListJobsJobConnectionNodesJob, error) {
+	var after *string
+
+	for {
+		response, err := jobsList(ctx, client, defaultJobsPageSize, after)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range response.Jobs.Nodes {
+			if strings.EqualFold(response.Jobs.Nodes[i].JobId, jobId) {
+				return &response.Jobs.Nodes[i], nil
+			}
+		}
+
+		pageInfo := response.Jobs.PageInfo
+		if !pageInfo.HasNextPage || pageInfo.EndCursor == nil {
+			return nil, nil
+		}
+		after = pageInfo.EndCursor
+	}
+}
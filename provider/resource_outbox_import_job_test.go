@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+func TestFindJob(t *testing.T) {
+	const (
+		firstJobId  = "40000000-0000-0000-0000-00000000000a"
+		secondJobId = "40000000-0000-0000-0000-00000000000b"
+	)
+
+	client := &fakeClient{respond: func(req *graphql.Request) (string, bool) {
+		variables, ok := req.Variables.(*__jobsListInput)
+		if !ok {
+			return "", false
+		}
+		if variables.After == nil {
+			return `{"jobs": {"pageInfo": {"hasNextPage": true, "endCursor": "1"}, "nodes": [{"jobId": "` + firstJobId + `", "name": "first"}]}}`, true
+		}
+		return `{"jobs": {"pageInfo": {"hasNextPage": false, "endCursor": "2"}, "nodes": [{"jobId": "` + secondJobId + `", "name": "second"}]}}`, true
+	}}
+
+	cases := []struct {
+		jobId string
+		name  string
+		pages int
+	}{
+		{firstJobId, "first", 1},
+		{"40000000-0000-0000-0000-00000000000A", "first", 1},
+		{secondJobId, "second", 2},
+		{"40000000-0000-0000-0000-00000000000B", "second", 2},
+		{"40000000-0000-0000-0000-00000000000c", "", 2},
+	}
+
+	for _, c := range cases {
+		client.operations = nil
+
+		job, err := findJob(context.Background(), client, c.jobId)
+		if err != nil {
+			t.Fatalf("%s: %s", c.jobId, err)
+		}
+
+		if c.name == "" {
+			if job != nil {
+				t.Errorf("%s: expected no job, got %s", c.jobId, job.Name)
+			}
+		} else if job == nil || job.Name != c.name {
+			t.Errorf("%s: expected the %s job, got %v", c.jobId, c.name, job)
+		}
+
+		if len(client.operations) != c.pages {
+			t.Errorf("%s: expected %d pages to be read, got %d", c.jobId, c.pages, len(client.operations))
+		}
+	}
+}
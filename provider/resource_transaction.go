@@ -0,0 +1,592 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &TransactionResource{}
+var _ resource.ResourceWithModifyPlan = &TransactionResource{}
+var _ resource.ResourceWithIdentity = &TransactionResource{}
+var _ resource.ResourceWithImportState = &TransactionResource{}
+
+func NewTransactionResource() resource.Resource {
+	return &TransactionResource{}
+}
+
+type TransactionResource struct {
+	client        *graphql.Client
+	configUnknown bool
+	readOnly      bool
+	auditLog      *auditLog
+}
+
+type TransactionResourceModel struct {
+	TransactionId  UUIDValue                 `tfsdk:"transaction_id"`
+	TxTemplateCode types.String              `tfsdk:"tx_template_code"`
+	Params         types.Dynamic             `tfsdk:"params"`
+	TxTemplateId   UUIDValue                 `tfsdk:"tx_template_id"`
+	JournalId      UUIDValue                 `tfsdk:"journal_id"`
+	Effective      types.String              `tfsdk:"effective"`
+	CorrelationId  types.String              `tfsdk:"correlation_id"`
+	ExternalId     types.String              `tfsdk:"external_id"`
+	Description    types.String              `tfsdk:"description"`
+	Reversal       *TransactionReversalModel `tfsdk:"reversal"`
+}
+
+type TransactionReversalModel struct {
+	TxTemplateCode types.String  `tfsdk:"tx_template_code"`
+	Params         types.Dynamic `tfsdk:"params"`
+	TransactionId  UUIDValue     `tfsdk:"transaction_id"`
+}
+
+// reversalTransactionId derives the ID of the compensating transaction posted
+// on destroy, so a retried destroy never posts the reversal twice. The ID
+// depends on the posting and on the reversal itself, a transaction replaced
+// under the same ID is therefore reversed again instead of being skipped.
+func reversalTransactionId(data *TransactionResourceModel) (string, error) {
+	namespace, err := uuid.Parse(data.TransactionId.ValueString())
+	if err != nil {
+		return "", err
+	}
+
+	params, err := paramsToJson(data.Params)
+	if err != nil {
+		return "", err
+	}
+
+	reversalParams, err := paramsToJson(data.Reversal.Params)
+	if err != nil {
+		return "", err
+	}
+
+	contents, err := json.Marshal(map[string]interface{}{
+		"txTemplateCode":         data.TxTemplateCode.ValueString(),
+		"params":                 params,
+		"reversalTxTemplateCode": data.Reversal.TxTemplateCode.ValueString(),
+		"reversalParams":         reversalParams,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return uuid.NewSHA1(namespace, append([]byte("reversal:"), contents...)).String(), nil
+}
+
+// planReversalTransactionId sets the reversal ID of plan once everything it is
+// derived from is known.
+func planReversalTransactionId(plan *TransactionResourceModel) error {
+	if plan.Reversal == nil {
+		return nil
+	}
+
+	if plan.TransactionId.IsUnknown() || plan.TxTemplateCode.IsUnknown() || plan.Reversal.TxTemplateCode.IsUnknown() ||
+		!paramsKnown(plan.Params) || !paramsKnown(plan.Reversal.Params) {
+		plan.Reversal.TransactionId = NewUUIDUnknown()
+		return nil
+	}
+
+	reversalId, err := reversalTransactionId(plan)
+	if err != nil {
+		return err
+	}
+
+	plan.Reversal.TransactionId = NewUUIDValue(reversalId)
+
+	return nil
+}
+
+// paramsKnown reports whether params can be converted to JSON.
+func paramsKnown(params types.Dynamic) bool {
+	if params.IsUnknown() || params.IsUnderlyingValueUnknown() {
+		return false
+	}
+
+	_, err := paramsToJson(params)
+	return err == nil
+}
+
+func (r *TransactionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transaction"
+}
+
+func (r *TransactionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Cala transaction posted through a tx template. Posted entries are immutable, any change forces a new transaction to be posted.",
+		Blocks: map[string]schema.Block{
+			"reversal": schema.SingleNestedBlock{
+				MarkdownDescription: "Compensating transaction posted when the resource is destroyed. Without it destroying only removes the transaction from the state.",
+				Attributes: map[string]schema.Attribute{
+					"tx_template_code": schema.StringAttribute{
+						MarkdownDescription: "Code of the tx template used to post the reversal.",
+						Required:            true,
+					},
+					"params": schema.DynamicAttribute{
+						MarkdownDescription: "Params passed to the reversal tx template. Defaults to the params of the transaction.",
+						Optional:            true,
+					},
+					"transaction_id": schema.StringAttribute{
+						CustomType:          UUIDType{},
+						MarkdownDescription: "Deterministic ID of the reversal transaction, derived from the transaction and the reversal.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		Attributes: map[string]schema.Attribute{
+			"transaction_id": schema.StringAttribute{
+				CustomType:          UUIDType{},
+				MarkdownDescription: "ID of the transaction. It must change whenever `tx_template_code` or `params` change, since the replacement is posted as a new transaction.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tx_template_code": schema.StringAttribute{
+				MarkdownDescription: "Code of the tx template used to post the transaction.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(transactionPostedString, "Changing the tx template of a posted transaction requires a new transaction.", ""),
+				},
+			},
+			"params": schema.DynamicAttribute{
+				MarkdownDescription: "Params passed to the tx template, as an object keyed by param name. They are checked against the param definitions of the tx template during plan.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Dynamic{
+					dynamicplanmodifier.RequiresReplaceIf(transactionPostedDynamic, "Changing the params of a posted transaction requires a new transaction.", ""),
+				},
+			},
+			"tx_template_id": schema.StringAttribute{
+				CustomType:          UUIDType{},
+				MarkdownDescription: "ID of the tx template the transaction was posted with.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"journal_id": schema.StringAttribute{
+				CustomType:          UUIDType{},
+				MarkdownDescription: "ID of the journal the transaction was posted to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"effective": schema.StringAttribute{
+				MarkdownDescription: "Effective date of the transaction.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"correlation_id": schema.StringAttribute{
+				MarkdownDescription: "Correlation ID of the transaction.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"external_id": schema.StringAttribute{
+				MarkdownDescription: "External ID of the transaction.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the transaction.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *TransactionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("ID of the transaction.")
+}
+
+func (r *TransactionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*CalaProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *CalaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &providerData.Client
+	r.configUnknown = providerData.ConfigUnknown
+	r.readOnly = providerData.ReadOnly
+	r.auditLog = providerData.AuditLog
+}
+
+// transactionPosted reports whether the transaction in the state was posted by
+// this resource. Imported transactions have no tx_template_code or params
+// until they are taken from the configuration, which does not post them again.
+func transactionPosted(ctx context.Context, state tfsdk.State) (bool, diag.Diagnostics) {
+	var code types.String
+	diags := state.GetAttribute(ctx, path.Root("tx_template_code"), &code)
+	return !code.IsNull(), diags
+}
+
+func transactionPostedString(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace, resp.Diagnostics = transactionPosted(ctx, req.State)
+}
+
+func transactionPostedDynamic(ctx context.Context, req planmodifier.DynamicRequest, resp *dynamicplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace, resp.Diagnostics = transactionPosted(ctx, req.State)
+}
+
+func (r *TransactionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to post when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state *TransactionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A replaced transaction is posted again, which the server refuses or
+	// ignores for an ID that is already taken.
+	if state != nil && !state.TxTemplateCode.IsNull() && (!state.TxTemplateCode.Equal(plan.TxTemplateCode) || !state.Params.Equal(plan.Params)) &&
+		!plan.TransactionId.IsUnknown() && strings.EqualFold(plan.TransactionId.ValueString(), state.TransactionId.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("transaction_id"),
+			"Transaction ID Not Changed",
+			fmt.Sprintf("Transaction %s is already posted. Changing tx_template_code or params posts a new transaction, which needs a new transaction_id.", state.TransactionId.ValueString()),
+		)
+		return
+	}
+
+	if err := planReversalTransactionId(plan); err != nil {
+		resp.Diagnostics.AddError("Invalid Transaction ID", fmt.Sprintf("Unable to derive the reversal transaction ID: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+
+	// Nothing to look up without a configured client.
+	if resp.Diagnostics.HasError() || r.client == nil || r.configUnknown {
+		return
+	}
+
+	// The params of a posted transaction are only checked again when it is
+	// replaced.
+	if state == nil || !state.TxTemplateCode.Equal(plan.TxTemplateCode) || !state.Params.Equal(plan.Params) {
+		resp.Diagnostics.Append(r.validateParams(ctx, plan.TxTemplateCode, plan.Params, path.Root("params"))...)
+	}
+
+	if plan.Reversal != nil && !plan.Reversal.Params.IsNull() {
+		resp.Diagnostics.Append(r.validateParams(ctx, plan.Reversal.TxTemplateCode, plan.Reversal.Params, path.Root("reversal").AtName("params"))...)
+	}
+}
+
+// validateParams checks params against the param definitions of the tx
+// template with the given code. Templates that do not exist yet, such as
+// those created in the same apply, are not checked.
+func (r *TransactionResource) validateParams(ctx context.Context, code types.String, params types.Dynamic, attributePath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if code.IsUnknown() || code.IsNull() || params.IsUnknown() || params.IsUnderlyingValueUnknown() {
+		return diags
+	}
+
+	response, err := txTemplateGetByCode(ctx, *r.client, code.ValueString())
+	if err != nil {
+		diags.AddAttributeWarning(attributePath, "Params Not Validated", fmt.Sprintf("Unable to read tx template %q, got error: %s", code.ValueString(), err))
+		return diags
+	}
+
+	if response.TxTemplateByCode == nil {
+		return diags
+	}
+
+	var values map[string]interface{}
+
+	if !params.IsNull() && !params.IsUnderlyingValueNull() {
+		decoded, err := attrToInterface(params.UnderlyingValue())
+		if err != nil {
+			// Params containing unknown values are checked once they are known.
+			return diags
+		}
+		m, ok := decoded.(map[string]interface{})
+		if !ok {
+			diags.AddAttributeError(attributePath, "Invalid Params", "params must be an object.")
+			return diags
+		}
+		values = m
+	}
+
+	declared := map[string]bool{}
+
+	for _, definition := range response.TxTemplateByCode.Params {
+		declared[definition.Name] = true
+
+		value, ok := values[definition.Name]
+		if !ok || value == nil {
+			if definition.Default == nil {
+				diags.AddAttributeError(
+					attributePath,
+					"Missing Param",
+					fmt.Sprintf("Tx template %q requires the %s param %q, which has no default.", code.ValueString(), definition.Type, definition.Name),
+				)
+			}
+			continue
+		}
+
+		if _, err := paramValue(definition.Type, value); err != nil {
+			diags.AddAttributeError(
+				attributePath.AtName(definition.Name),
+				"Invalid Param",
+				fmt.Sprintf("Param %q of tx template %q: %s.", definition.Name, code.ValueString(), err),
+			)
+		}
+	}
+
+	for _, name := range sortedKeys(values) {
+		if !declared[name] {
+			diags.AddAttributeError(
+				attributePath.AtName(name),
+				"Undeclared Param",
+				fmt.Sprintf("Tx template %q does not declare a param %q.", code.ValueString(), name),
+			)
+		}
+	}
+
+	return diags
+}
+
+func (r *TransactionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("create", "transaction"))
+		return
+	}
+
+	defer r.auditLog.report(&resp.Diagnostics)
+
+	var data *TransactionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, err := paramsToJson(data.Params)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Params", fmt.Sprintf("Unable to convert params to JSON: %s", err))
+		return
+	}
+
+	input := TransactionInput{
+		TransactionId:  data.TransactionId.ValueString(),
+		TxTemplateCode: data.TxTemplateCode.ValueString(),
+		Params:         params,
+	}
+
+	response, err := transactionPost(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to post transaction, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "posted a transaction")
+
+	transaction := response.TransactionPost.Transaction
+
+	data.TransactionId = NewUUIDValue(transaction.TransactionId)
+	data.TxTemplateId = NewUUIDValue(transaction.TxTemplateId)
+	data.JournalId = NewUUIDValue(transaction.JournalId)
+	data.Effective = types.StringValue(transaction.Effective)
+	data.CorrelationId = types.StringValue(transaction.CorrelationId)
+	data.ExternalId = types.StringPointerValue(transaction.ExternalId)
+	data.Description = types.StringPointerValue(transaction.Description)
+
+	if data.Reversal != nil {
+		reversalId, err := reversalTransactionId(data)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Transaction ID", fmt.Sprintf("Unable to derive the reversal transaction ID: %s", err))
+			return
+		}
+		data.Reversal.TransactionId = NewUUIDValue(reversalId)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, IdIdentityModel{Id: types.StringValue(data.TransactionId.ValueString())})...)
+}
+
+func (r *TransactionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The prior state is kept while the provider configuration is unknown.
+	if r.configUnknown {
+		tflog.Debug(ctx, "provider configuration is unknown, skipping refresh")
+		return
+	}
+
+	var data *TransactionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	response, err := transactionGet(ctx, *r.client, data.TransactionId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read transaction, got error: %s", err))
+		return
+	}
+
+	if response.Transaction == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	transaction := response.Transaction
+
+	data.TransactionId = NewUUIDValue(transaction.TransactionId)
+	data.TxTemplateId = NewUUIDValue(transaction.TxTemplateId)
+	data.JournalId = NewUUIDValue(transaction.JournalId)
+	data.Effective = types.StringValue(transaction.Effective)
+	data.CorrelationId = types.StringValue(transaction.CorrelationId)
+	data.ExternalId = types.StringPointerValue(transaction.ExternalId)
+	data.Description = types.StringPointerValue(transaction.Description)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, IdIdentityModel{Id: types.StringValue(data.TransactionId.ValueString())})...)
+}
+
+// Update only ever changes the reversal, which is not sent to the server until
+// the resource is destroyed, or takes the tx_template_code and params of an
+// imported transaction from the configuration.
+func (r *TransactionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("update", "transaction"))
+		return
+	}
+
+	var data *TransactionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Reversal != nil {
+		reversalId, err := reversalTransactionId(data)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Transaction ID", fmt.Sprintf("Unable to derive the reversal transaction ID: %s", err))
+			return
+		}
+		data.Reversal.TransactionId = NewUUIDValue(reversalId)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, IdIdentityModel{Id: types.StringValue(data.TransactionId.ValueString())})...)
+}
+
+func (r *TransactionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("delete", "transaction"))
+		return
+	}
+
+	defer r.auditLog.report(&resp.Diagnostics)
+
+	var data *TransactionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Reversal == nil {
+		resp.Diagnostics.AddWarning(
+			"Transaction Not Reversed",
+			"Posted transactions cannot be deleted. The transaction was removed from the Terraform state but its entries remain in the ledger. "+
+				"Configure a reversal block to post a compensating transaction on destroy.",
+		)
+		return
+	}
+
+	reversalId, err := reversalTransactionId(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Transaction ID", fmt.Sprintf("Unable to derive the reversal transaction ID: %s", err))
+		return
+	}
+
+	// The reversal may already have been posted by a previous destroy that
+	// failed afterwards.
+	existing, err := transactionGet(ctx, *r.client, reversalId)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read reversal transaction, got error: %s", err))
+		return
+	}
+
+	if existing.Transaction != nil {
+		tflog.Debug(ctx, "reversal transaction already posted", map[string]interface{}{"reversal_transaction_id": reversalId})
+		return
+	}
+
+	reversalParams := data.Reversal.Params
+	if reversalParams.IsNull() {
+		reversalParams = data.Params
+	}
+
+	params, err := paramsToJson(reversalParams)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Params", fmt.Sprintf("Unable to convert reversal params to JSON: %s", err))
+		return
+	}
+
+	input := TransactionInput{
+		TransactionId:  reversalId,
+		TxTemplateCode: data.Reversal.TxTemplateCode.ValueString(),
+		Params:         params,
+	}
+
+	_, err = transactionPost(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to post reversal transaction, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "posted a reversal transaction")
+}
+
+func (r *TransactionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("transaction_id"), path.Root("id"), req, resp)
+}
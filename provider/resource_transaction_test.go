@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	testTransactionId = "3f5a1c2e-8d4b-4f6a-9e1c-7b2d0a4c6e81"
+	testReplacementId = "6e0b2f7a-1c3d-4e5f-8a9b-0c1d2e3f4a5b"
+)
+
+func testTransaction(transactionId string, amount string) *TransactionResourceModel {
+	return &TransactionResourceModel{
+		TransactionId:  NewUUIDValue(transactionId),
+		TxTemplateCode: types.StringValue("OPENING_BALANCE"),
+		Params: types.DynamicValue(types.ObjectValueMust(
+			map[string]attr.Type{"amount": types.StringType},
+			map[string]attr.Value{"amount": types.StringValue(amount)},
+		)),
+		TxTemplateId:  NewUUIDUnknown(),
+		JournalId:     NewUUIDUnknown(),
+		Effective:     types.StringUnknown(),
+		CorrelationId: types.StringUnknown(),
+		ExternalId:    types.StringUnknown(),
+		Description:   types.StringUnknown(),
+	}
+}
+
+func transactionSchema(ctx context.Context) (resource.SchemaResponse, tftypes.Value) {
+	schemaResp := resource.SchemaResponse{}
+	(&TransactionResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	return schemaResp, tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+}
+
+func TestTransactionModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	schemaResp, null := transactionSchema(ctx)
+	schema := schemaResp.Schema
+
+	imported := testTransaction(testTransactionId, "1000.00")
+	imported.TxTemplateCode = types.StringNull()
+	imported.Params = types.DynamicNull()
+
+	cases := []struct {
+		name  string
+		plan  *TransactionResourceModel
+		state *TransactionResourceModel
+		err   bool
+	}{
+		{"create", testTransaction(testTransactionId, "1000.00"), nil, false},
+		{"unchanged", testTransaction(testTransactionId, "1000.00"), testTransaction(testTransactionId, "1000.00"), false},
+		{"replaced with a new ID", testTransaction(testReplacementId, "2000.00"), testTransaction(testTransactionId, "1000.00"), false},
+		{"replaced with the same ID", testTransaction(testTransactionId, "2000.00"), testTransaction(testTransactionId, "1000.00"), true},
+		{"imported", testTransaction(testTransactionId, "1000.00"), imported, false},
+	}
+
+	for _, c := range cases {
+		req := resource.ModifyPlanRequest{
+			Plan:  tfsdk.Plan{Schema: schema, Raw: null},
+			State: tfsdk.State{Schema: schema, Raw: null},
+		}
+
+		diags := req.Plan.Set(ctx, c.plan)
+		if c.state != nil {
+			diags.Append(req.State.Set(ctx, c.state)...)
+		}
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+		(&TransactionResource{}).ModifyPlan(ctx, req, resp)
+
+		if resp.Diagnostics.HasError() != c.err {
+			t.Errorf("%s: expected error to be %t, got %v", c.name, c.err, resp.Diagnostics)
+		}
+	}
+}
+
+func TestTransactionPosted(t *testing.T) {
+	ctx := context.Background()
+	schemaResp, null := transactionSchema(ctx)
+
+	imported := testTransaction(testTransactionId, "1000.00")
+	imported.TxTemplateCode = types.StringNull()
+
+	for _, c := range []struct {
+		state  *TransactionResourceModel
+		posted bool
+	}{
+		{testTransaction(testTransactionId, "1000.00"), true},
+		{imported, false},
+	} {
+		state := tfsdk.State{Schema: schemaResp.Schema, Raw: null}
+		if diags := state.Set(ctx, c.state); diags.HasError() {
+			t.Fatal(diags)
+		}
+
+		// Imported transactions take their tx template and params from the
+		// configuration instead of being replaced.
+		posted, diags := transactionPosted(ctx, state)
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+		if posted != c.posted {
+			t.Errorf("%s: expected posted to be %t", c.state.TxTemplateCode, c.posted)
+		}
+	}
+}
+
+func TestTransactionDeleteAfterReplace(t *testing.T) {
+	ctx := context.Background()
+	schemaResp, null := transactionSchema(ctx)
+
+	posted := map[string]bool{}
+	var reversals []string
+
+	client := &fakeClient{respond: func(req *graphql.Request) (string, bool) {
+		switch variables := req.Variables.(type) {
+		case *__transactionGetInput:
+			if posted[variables.Id] {
+				return `{"transaction": {"transactionId": "` + variables.Id + `"}}`, true
+			}
+			return `{"transaction": null}`, true
+		case *__transactionPostInput:
+			posted[variables.Input.TransactionId] = true
+			reversals = append(reversals, variables.Input.TransactionId)
+			return `{"transactionPost": {"transaction": {"transactionId": "` + variables.Input.TransactionId + `"}}}`, true
+		}
+		return "", false
+	}}
+	var graphqlClient graphql.Client = client
+	r := &TransactionResource{client: &graphqlClient}
+
+	destroy := func(data *TransactionResourceModel) {
+		t.Helper()
+
+		data.Reversal = &TransactionReversalModel{
+			TxTemplateCode: types.StringValue("OPENING_BALANCE_REVERSAL"),
+			Params:         types.DynamicNull(),
+		}
+		if err := planReversalTransactionId(data); err != nil {
+			t.Fatal(err)
+		}
+
+		req := resource.DeleteRequest{State: tfsdk.State{Schema: schemaResp.Schema, Raw: null}}
+		if diags := req.State.Set(ctx, data); diags.HasError() {
+			t.Fatal(diags)
+		}
+
+		resp := &resource.DeleteResponse{}
+		r.Delete(ctx, req, resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Fatal(resp.Diagnostics)
+		}
+	}
+
+	destroy(testTransaction(testTransactionId, "1000.00"))
+
+	// The same transaction ID posted again with other params, for instance
+	// after being imported, is reversed on its own.
+	destroy(testTransaction(testTransactionId, "2000.00"))
+
+	if len(reversals) != 2 || reversals[0] == reversals[1] {
+		t.Fatalf("expected both postings to be reversed under their own ID, got %v", reversals)
+	}
+
+	// A retried destroy finds its reversal and does not post it twice.
+	destroy(testTransaction(testTransactionId, "2000.00"))
+
+	if len(reversals) != 2 {
+		t.Fatalf("expected the reversal not to be posted again, got %v", reversals)
+	}
+}
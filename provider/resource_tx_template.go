@@ -0,0 +1,868 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &TxTemplateResource{}
+var _ resource.ResourceWithValidateConfig = &TxTemplateResource{}
+var _ resource.ResourceWithModifyPlan = &TxTemplateResource{}
+var _ resource.ResourceWithIdentity = &TxTemplateResource{}
+
+func NewTxTemplateResource() resource.Resource {
+	return &TxTemplateResource{}
+}
+
+type TxTemplateResource struct {
+	client        *graphql.Client
+	defaults      CalaProviderDefaults
+	configUnknown bool
+	readOnly      bool
+	auditLog      *auditLog
+}
+
+type TxTemplateResourceModel struct {
+	TxTemplateId  UUIDValue                   `tfsdk:"id"`
+	Code          types.String                `tfsdk:"code"`
+	BaseCode      types.String                `tfsdk:"base_code"`
+	CodeSuffix    types.String                `tfsdk:"code_suffix"`
+	CurrentCode   types.String                `tfsdk:"current_code"`
+	PreviousCodes types.List                  `tfsdk:"previous_codes"`
+	Description   types.String                `tfsdk:"description"`
+	Params        []TxTemplateParamModel      `tfsdk:"params"`
+	Transaction   *TxTemplateTransactionModel `tfsdk:"transaction"`
+	Entries       []TxTemplateEntryModel      `tfsdk:"entries"`
+	Version       types.Int64                 `tfsdk:"version"`
+}
+
+const (
+	txTemplateCodeSuffixHash    = "HASH"
+	txTemplateCodeSuffixVersion = "VERSION"
+)
+
+// txTemplateIdNamespace is the namespace of the IDs derived from the code of
+// versioned tx templates.
+var txTemplateIdNamespace = uuid.MustParse("5d0ac1e5-7a3c-4f0e-9b8d-2c41e6f0a7b3")
+
+var paramDataTypes = []string{
+	string(ParamDataTypeString),
+	string(ParamDataTypeInteger),
+	string(ParamDataTypeDecimal),
+	string(ParamDataTypeBoolean),
+	string(ParamDataTypeUuid),
+	string(ParamDataTypeDate),
+	string(ParamDataTypeTimestamp),
+	string(ParamDataTypeJson),
+}
+
+func isUuid(value string) error {
+	_, err := uuid.Parse(value)
+	return err
+}
+
+func isDate(value string) error {
+	_, err := time.Parse(time.DateOnly, value)
+	return err
+}
+
+// txTemplateTransactionExpectations are the types the expressions of the
+// transaction attribute have to evaluate to.
+var txTemplateTransactionExpectations = map[string]exprExpectation{
+	"effective":      {types: []exprType{exprTypeDate, exprTypeString}, literal: isDate},
+	"journal_id":     {types: []exprType{exprTypeUuid, exprTypeString}, literal: isUuid},
+	"correlation_id": {types: []exprType{exprTypeString}},
+	"external_id":    {types: []exprType{exprTypeString}},
+	"description":    {types: []exprType{exprTypeString}},
+	"metadata":       {types: []exprType{exprTypeMap}},
+}
+
+// txTemplateEntryExpectations are the types the expressions of each entry
+// have to evaluate to.
+var txTemplateEntryExpectations = map[string]exprExpectation{
+	"entry_type":  {types: []exprType{exprTypeString}},
+	"account_id":  {types: []exprType{exprTypeUuid, exprTypeString}, literal: isUuid},
+	"layer":       {types: []exprType{exprTypeString}, values: []string{"SETTLED", "PENDING", "ENCUMBRANCE"}},
+	"direction":   {types: []exprType{exprTypeString}, values: []string{"DEBIT", "CREDIT"}},
+	"units":       {types: []exprType{exprTypeDecimal}},
+	"currency":    {types: []exprType{exprTypeString}},
+	"description": {types: []exprType{exprTypeString}},
+}
+
+func (r *TxTemplateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tx_template"
+}
+
+func (r *TxTemplateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	expression := func(description string, required bool) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: description,
+			Required:            required,
+			Optional:            !required,
+		}
+	}
+
+	// defaulted expressions are filled in from the provider defaults when
+	// they are omitted.
+	defaulted := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			Computed:            true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Cala tx template. Tx templates are immutable, any change forces a new template to be created. " +
+			"With `code`, the template is replaced. With `base_code`, the code is derived from the content, a change creates " +
+			"a template with a new code and the previous templates are kept so in-flight callers can still post to them. " +
+			"Expressions are parsed and type checked against the declared params during validation, calls to functions the provider does not know about are reported as warnings.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				CustomType:          UUIDType{},
+				MarkdownDescription: "ID of the tx template. Required with `code`, derived from the code with `base_code`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("base_code")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(txTemplateUnversionedString, "Changing the ID of an unversioned tx template requires a new template.", ""),
+				},
+			},
+			"code": schema.StringAttribute{
+				MarkdownDescription: "Code of the tx template, used to reference it when posting transactions. Exactly one of `code` " +
+					"and `base_code` must be set, with `base_code` the code is computed.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("base_code")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(txTemplateUnversionedString, "Changing the code of an unversioned tx template requires a new template.", ""),
+				},
+			},
+			"base_code": schema.StringAttribute{
+				MarkdownDescription: "Base code of a versioned tx template. The code is the base code followed by a suffix chosen " +
+					"by `code_suffix`, e.g. `DEPOSIT_1A2B3C4D` or `DEPOSIT_V2`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"code_suffix": schema.StringAttribute{
+				MarkdownDescription: "Suffix appended to `base_code`: `HASH` for the first 8 characters of a hash of the content, " +
+					"`VERSION` for `V` followed by a number incremented on every change. Defaults to `HASH`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(txTemplateCodeSuffixHash),
+				Validators: []validator.String{
+					stringvalidator.OneOf(txTemplateCodeSuffixHash, txTemplateCodeSuffixVersion),
+				},
+			},
+			"current_code": schema.StringAttribute{
+				MarkdownDescription: "Code of the current tx template, the one applications should post with.",
+				Computed:            true,
+			},
+			"previous_codes": schema.ListAttribute{
+				MarkdownDescription: "Codes of the templates previously created by a versioned tx template, oldest first. Reverting to earlier content makes its template current again, it is not listed here.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the tx template.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(txTemplateUnversionedString, "Changing an unversioned tx template requires a new template.", ""),
+				},
+			},
+			"params": schema.ListNestedAttribute{
+				MarkdownDescription: "Params accepted by the tx template.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplaceIf(txTemplateUnversionedList, "Changing an unversioned tx template requires a new template.", ""),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the param, referenced as `params.<name>` in expressions.",
+							Required:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "ParamDataType of the param.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(paramDataTypes...),
+							},
+						},
+						"default": expression("Default expression of the param, checked against its `type`.", false),
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the param.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"transaction": schema.SingleNestedAttribute{
+				MarkdownDescription: "Expressions used to build the transaction.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplaceIf(txTemplateUnversionedObject, "Changing an unversioned tx template requires a new template.", ""),
+				},
+				Attributes: map[string]schema.Attribute{
+					"effective":      expression("Expression for the effective date, e.g. `date()`.", true),
+					"journal_id":     defaulted("Expression for the journal ID. Defaults to the provider's `default_journal_id`."),
+					"correlation_id": expression("Expression for the correlation ID.", false),
+					"external_id":    expression("Expression for the external ID.", false),
+					"description":    expression("Expression for the description.", false),
+					"metadata":       expression("Expression for the metadata.", false),
+				},
+			},
+			"entries": schema.ListNestedAttribute{
+				MarkdownDescription: "Expressions used to build each entry of the transaction.",
+				Required:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplaceIf(txTemplateUnversionedList, "Changing an unversioned tx template requires a new template.", ""),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"entry_type":  expression("Expression for the entry type.", true),
+						"account_id":  expression("Expression for the account ID.", true),
+						"layer":       expression("Expression for the layer, e.g. `SETTLED`.", true),
+						"direction":   expression("Expression for the direction, e.g. `DEBIT`.", true),
+						"units":       expression("Expression for the units, e.g. `params.amount`.", true),
+						"currency":    defaulted("Expression for the currency, e.g. `'USD'`. Defaults to the provider's `default_currency`."),
+						"description": expression("Expression for the description.", false),
+					},
+				},
+			},
+			"version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the tx template.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *TxTemplateResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("ID of the tx template.")
+}
+
+func (r *TxTemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*CalaProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *CalaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &providerData.Client
+	r.defaults = providerData.Defaults
+	r.configUnknown = providerData.ConfigUnknown
+	r.readOnly = providerData.ReadOnly
+	r.auditLog = providerData.AuditLog
+}
+
+func (r *TxTemplateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var id, baseCode types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("base_code"), &baseCode)...)
+
+	if id.IsNull() && baseCode.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Missing ID", "The id attribute is required unless base_code is set.")
+	}
+
+	checker, diags := txTemplateChecker(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+
+	// The params are not known yet, the expressions can only be checked once
+	// they are.
+	if checker == nil {
+		return
+	}
+
+	var params types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("params"), &params)...)
+
+	if !params.IsNull() && !params.IsUnknown() {
+		for i := range params.Elements() {
+			attributePath := path.Root("params").AtListIndex(i)
+			resp.Diagnostics.Append(validateDefaultExpression(ctx, req.Config, attributePath)...)
+		}
+	}
+
+	for name, expected := range txTemplateTransactionExpectations {
+		attributePath := path.Root("transaction").AtName(name)
+		resp.Diagnostics.Append(validateExpression(ctx, req.Config, attributePath, checker, expected)...)
+	}
+
+	var entries types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("entries"), &entries)...)
+
+	if entries.IsNull() || entries.IsUnknown() {
+		return
+	}
+
+	for i := range entries.Elements() {
+		for name, expected := range txTemplateEntryExpectations {
+			attributePath := path.Root("entries").AtListIndex(i).AtName(name)
+			resp.Diagnostics.Append(validateExpression(ctx, req.Config, attributePath, checker, expected)...)
+		}
+	}
+}
+
+// txTemplateChecker builds an expression checker from the params declared in
+// config. It returns nil when the params are not known yet.
+func txTemplateChecker(ctx context.Context, config tfsdk.Config) (*exprChecker, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var paramsList types.List
+
+	diags.Append(config.GetAttribute(ctx, path.Root("params"), &paramsList)...)
+
+	if diags.HasError() || paramsList.IsUnknown() {
+		return nil, diags
+	}
+
+	checker := &exprChecker{params: map[string]exprType{}}
+
+	if paramsList.IsNull() {
+		return checker, diags
+	}
+
+	var params []TxTemplateParamModel
+	diags.Append(paramsList.ElementsAs(ctx, &params, false)...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for i, param := range params {
+		if param.Name.IsUnknown() || param.Type.IsUnknown() {
+			return nil, diags
+		}
+
+		name := param.Name.ValueString()
+		if _, ok := checker.params[name]; ok {
+			diags.AddAttributeError(
+				path.Root("params").AtListIndex(i).AtName("name"),
+				"Duplicate Param",
+				fmt.Sprintf("The param %q is declared more than once.", name),
+			)
+			continue
+		}
+
+		t, err := paramExprType(ParamDataType(param.Type.ValueString()))
+		if err != nil {
+			// Reported by the validator of the type attribute.
+			t = exprTypeDyn
+		}
+
+		checker.params[name] = t
+	}
+
+	return checker, diags
+}
+
+// validateExpression checks the expression at attributePath, if it is set.
+func validateExpression(ctx context.Context, config tfsdk.Config, attributePath path.Path, checker *exprChecker, expected exprExpectation) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var value types.String
+
+	diags.Append(config.GetAttribute(ctx, attributePath, &value)...)
+
+	if diags.HasError() || value.IsNull() || value.IsUnknown() {
+		return diags
+	}
+
+	warnings, err := checker.checkExpression(value.ValueString(), expected)
+	if err != nil {
+		diags.AddAttributeError(attributePath, "Invalid Expression", fmt.Sprintf("Invalid expression %q: %s", value.ValueString(), err))
+	}
+
+	for _, warning := range warnings {
+		diags.AddAttributeWarning(attributePath, "Unchecked Expression", fmt.Sprintf("Expression %q: %s", value.ValueString(), warning))
+	}
+
+	return diags
+}
+
+// validateDefaultExpression checks that the default of the param at
+// paramPath evaluates to the declared type of the param. Defaults cannot
+// reference other params.
+func validateDefaultExpression(ctx context.Context, config tfsdk.Config, paramPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var param TxTemplateParamModel
+
+	diags.Append(config.GetAttribute(ctx, paramPath, &param)...)
+
+	if diags.HasError() || param.Default.IsNull() || param.Default.IsUnknown() || param.Type.IsUnknown() {
+		return diags
+	}
+
+	expected, err := paramExpectation(ParamDataType(param.Type.ValueString()))
+	if err != nil {
+		// Reported by the validator of the type attribute.
+		return diags
+	}
+
+	checker := &exprChecker{}
+	warnings, err := checker.checkExpression(param.Default.ValueString(), expected)
+	if err != nil {
+		diags.AddAttributeError(
+			paramPath.AtName("default"),
+			"Invalid Expression",
+			fmt.Sprintf("Invalid default %q for %s param: %s", param.Default.ValueString(), param.Type.ValueString(), err),
+		)
+	}
+
+	for _, warning := range warnings {
+		diags.AddAttributeWarning(
+			paramPath.AtName("default"),
+			"Unchecked Expression",
+			fmt.Sprintf("Default %q for %s param: %s", param.Default.ValueString(), param.Type.ValueString(), warning),
+		)
+	}
+
+	return diags
+}
+
+// txTemplateUnversioned reports whether the planned tx template is
+// unversioned, in which case any change requires a new template.
+func txTemplateUnversioned(ctx context.Context, plan tfsdk.Plan) (bool, diag.Diagnostics) {
+	var baseCode types.String
+	diags := plan.GetAttribute(ctx, path.Root("base_code"), &baseCode)
+	return baseCode.IsNull(), diags
+}
+
+func txTemplateUnversionedString(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace, resp.Diagnostics = txTemplateUnversioned(ctx, req.Plan)
+}
+
+func txTemplateUnversionedList(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace, resp.Diagnostics = txTemplateUnversioned(ctx, req.Plan)
+}
+
+func txTemplateUnversionedObject(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace, resp.Diagnostics = txTemplateUnversioned(ctx, req.Plan)
+}
+
+func (r *TxTemplateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to derive when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan, state *TxTemplateResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyDefaults(config, plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.BaseCode.IsNull() {
+		plan.CurrentCode = plan.Code
+		plan.PreviousCodes = types.ListValueMust(types.StringType, []attr.Value{})
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	hash, known := txTemplateContentHash(plan)
+
+	if state != nil {
+		stateHash, _ := txTemplateContentHash(state)
+
+		// Unchanged content keeps the current template.
+		if known && hash == stateHash && plan.CodeSuffix.Equal(state.CodeSuffix) {
+			plan.TxTemplateId = state.TxTemplateId
+			plan.Code = state.Code
+			plan.CurrentCode = state.CurrentCode
+			plan.PreviousCodes = state.PreviousCodes
+			plan.Version = state.Version
+			resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+			return
+		}
+	}
+
+	plan.Version = types.Int64Unknown()
+
+	if !known || plan.BaseCode.IsUnknown() || plan.CodeSuffix.IsUnknown() {
+		plan.TxTemplateId = NewUUIDUnknown()
+		plan.Code = types.StringUnknown()
+		plan.CurrentCode = types.StringUnknown()
+		plan.PreviousCodes = types.ListUnknown(types.StringType)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	code, previousCodes := txTemplateCode(plan, state, hash)
+
+	plan.TxTemplateId = NewUUIDValue(uuid.NewSHA1(txTemplateIdNamespace, []byte(code)).String())
+	plan.Code = types.StringValue(code)
+	plan.CurrentCode = plan.Code
+	plan.PreviousCodes = types.ListValueMust(types.StringType, previousCodes)
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// txTemplateCode derives the code of a versioned tx template from the hash of
+// its content, along with the codes of the templates created before it.
+// Reverting to earlier content derives a code that was already created, that
+// template becomes current again and is no longer listed as previous.
+func txTemplateCode(plan, state *TxTemplateResourceModel, hash string) (string, []attr.Value) {
+	previousCodes := []attr.Value{}
+	if state != nil {
+		previousCodes = append(previousCodes, state.PreviousCodes.Elements()...)
+		previousCodes = append(previousCodes, state.Code)
+	}
+
+	code := plan.BaseCode.ValueString() + "_" + hash[:8]
+	if plan.CodeSuffix.ValueString() == txTemplateCodeSuffixVersion {
+		code = fmt.Sprintf("%s_V%d", plan.BaseCode.ValueString(), len(previousCodes)+1)
+	}
+
+	return code, slices.DeleteFunc(previousCodes, func(previous attr.Value) bool {
+		return previous.Equal(types.StringValue(code))
+	})
+}
+
+// applyDefaults fills in the journal and currencies omitted from the
+// configuration with the provider defaults, as literal expressions.
+func (r *TxTemplateResource) applyDefaults(config, plan *TxTemplateResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The defaults are not known yet either, the values stay unknown.
+	if r.configUnknown {
+		return diags
+	}
+
+	if config.Transaction != nil && plan.Transaction != nil && config.Transaction.JournalId.IsNull() {
+		if r.defaults.JournalId == "" {
+			diags.AddAttributeError(
+				path.Root("transaction").AtName("journal_id"),
+				"Missing Journal",
+				"Set transaction.journal_id on the tx template or default_journal_id on the provider.",
+			)
+		} else {
+			plan.Transaction.JournalId = types.StringValue("'" + r.defaults.JournalId + "'")
+		}
+	}
+
+	for i, entry := range config.Entries {
+		if i >= len(plan.Entries) || !entry.Currency.IsNull() {
+			continue
+		}
+
+		if r.defaults.Currency == "" {
+			diags.AddAttributeError(
+				path.Root("entries").AtListIndex(i).AtName("currency"),
+				"Missing Currency",
+				"Set the currency of the entry or default_currency on the provider.",
+			)
+			continue
+		}
+
+		plan.Entries[i].Currency = types.StringValue("'" + r.defaults.Currency + "'")
+	}
+
+	return diags
+}
+
+// txTemplateInput builds the input creating the tx template described by
+// data.
+func txTemplateInput(data *TxTemplateResourceModel) TxTemplateCreateInput {
+	input := TxTemplateCreateInput{
+		TxTemplateId: data.TxTemplateId.ValueString(),
+		Code:         data.Code.ValueString(),
+		Description:  data.Description.ValueStringPointer(),
+	}
+
+	if data.Transaction != nil {
+		input.Transaction = TxTemplateTransactionInput{
+			Effective:     data.Transaction.Effective.ValueString(),
+			JournalId:     data.Transaction.JournalId.ValueString(),
+			CorrelationId: data.Transaction.CorrelationId.ValueStringPointer(),
+			ExternalId:    data.Transaction.ExternalId.ValueStringPointer(),
+			Description:   data.Transaction.Description.ValueStringPointer(),
+			Metadata:      data.Transaction.Metadata.ValueStringPointer(),
+		}
+	}
+
+	for _, param := range data.Params {
+		input.Params = append(input.Params, ParamDefinitionInput{
+			Name:        param.Name.ValueString(),
+			Type:        ParamDataType(param.Type.ValueString()),
+			Default:     param.Default.ValueStringPointer(),
+			Description: param.Description.ValueStringPointer(),
+		})
+	}
+
+	for _, entry := range data.Entries {
+		input.Entries = append(input.Entries, TxTemplateEntryInput{
+			EntryType:   entry.EntryType.ValueString(),
+			AccountId:   entry.AccountId.ValueString(),
+			Layer:       entry.Layer.ValueString(),
+			Direction:   entry.Direction.ValueString(),
+			Units:       entry.Units.ValueString(),
+			Currency:    entry.Currency.ValueString(),
+			Description: entry.Description.ValueStringPointer(),
+		})
+	}
+
+	return input
+}
+
+// txTemplateContentHash returns a hex encoded hash of everything but the ID
+// and code of the tx template. It reports false when the content is not known
+// yet.
+func txTemplateContentHash(data *TxTemplateResourceModel) (string, bool) {
+	values := []types.String{data.Description}
+
+	if data.Transaction == nil {
+		return "", false
+	}
+	values = append(values,
+		data.Transaction.Effective,
+		data.Transaction.JournalId,
+		data.Transaction.CorrelationId,
+		data.Transaction.ExternalId,
+		data.Transaction.Description,
+		data.Transaction.Metadata,
+	)
+	for _, param := range data.Params {
+		values = append(values, param.Name, param.Type, param.Default, param.Description)
+	}
+	for _, entry := range data.Entries {
+		values = append(values, entry.EntryType, entry.AccountId, entry.Layer, entry.Direction, entry.Units, entry.Currency, entry.Description)
+	}
+
+	for _, value := range values {
+		if value.IsUnknown() {
+			return "", false
+		}
+	}
+
+	input := txTemplateInput(data)
+	input.TxTemplateId = ""
+	input.Code = ""
+
+	encoded, err := json.Marshal(input)
+	if err != nil {
+		return "", false
+	}
+
+	sum := sha256.Sum256(encoded)
+
+	return strings.ToUpper(hex.EncodeToString(sum[:])), true
+}
+
+func (r *TxTemplateResource) create(ctx context.Context, data *TxTemplateResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	response, err := txTemplateCreate(ctx, *r.client, txTemplateInput(data))
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create tx template, got error: %s", err))
+		return diags
+	}
+
+	tflog.Trace(ctx, "created a tx template")
+
+	txTemplate := response.TxTemplateCreate.TxTemplate
+
+	data.TxTemplateId = NewUUIDValue(txTemplate.TxTemplateId)
+	data.Code = types.StringValue(txTemplate.Code)
+	data.CurrentCode = data.Code
+	data.Version = types.Int64Value(int64(txTemplate.Version))
+
+	return diags
+}
+
+// reuse makes the previously created template with the planned ID current,
+// it is created again if it no longer exists.
+func (r *TxTemplateResource) reuse(ctx context.Context, data *TxTemplateResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	response, err := txTemplateGet(ctx, *r.client, data.TxTemplateId.ValueString())
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read tx template, got error: %s", err))
+		return diags
+	}
+
+	if response.TxTemplate == nil {
+		return r.create(ctx, data)
+	}
+
+	tflog.Trace(ctx, "reused a previous tx template")
+
+	data.Version = types.Int64Value(int64(response.TxTemplate.Version))
+
+	return diags
+}
+
+func (r *TxTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("create", "tx template"))
+		return
+	}
+
+	defer r.auditLog.report(&resp.Diagnostics)
+
+	var data *TxTemplateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.create(ctx, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, IdIdentityModel{Id: types.StringValue(data.TxTemplateId.ValueString())})...)
+}
+
+func (r *TxTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The prior state is kept while the provider configuration is unknown.
+	if r.configUnknown {
+		tflog.Debug(ctx, "provider configuration is unknown, skipping refresh")
+		return
+	}
+
+	var data *TxTemplateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := txTemplateGet(ctx, *r.client, data.TxTemplateId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read tx template, got error: %s", err))
+		return
+	}
+
+	if response.TxTemplate == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	txTemplate := &response.TxTemplate.txTemplateFields
+
+	data.TxTemplateId = NewUUIDValue(txTemplate.TxTemplateId)
+	data.Code = types.StringValue(txTemplate.Code)
+	data.CurrentCode = data.Code
+	data.Description = types.StringPointerValue(txTemplate.Description)
+	data.Version = types.Int64Value(int64(txTemplate.Version))
+	data.Transaction = flattenTxTemplateTransaction(txTemplate)
+	data.Entries = flattenTxTemplateEntries(txTemplate)
+
+	// Keep params null rather than empty when the template declares none.
+	data.Params = nil
+	if len(txTemplate.Params) > 0 {
+		data.Params = flattenTxTemplateParams(txTemplate)
+	}
+
+	if data.PreviousCodes.IsNull() {
+		data.PreviousCodes = types.ListValueMust(types.StringType, []attr.Value{})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, IdIdentityModel{Id: types.StringValue(data.TxTemplateId.ValueString())})...)
+}
+
+func (r *TxTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("update", "tx template"))
+		return
+	}
+
+	defer r.auditLog.report(&resp.Diagnostics)
+
+	var plan, state *TxTemplateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tx templates cannot be updated, a versioned tx template whose code
+	// changed creates a new template and leaves the previous one in place.
+	// A code that was created before is current again and is not posted a
+	// second time.
+	if !plan.Code.Equal(state.Code) {
+		if slices.ContainsFunc(state.PreviousCodes.Elements(), plan.Code.Equal) {
+			resp.Diagnostics.Append(r.reuse(ctx, plan)...)
+		} else {
+			resp.Diagnostics.Append(r.create(ctx, plan)...)
+		}
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, IdIdentityModel{Id: types.StringValue(plan.TxTemplateId.ValueString())})...)
+}
+
+func (r *TxTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.readOnly {
+		resp.Diagnostics.Append(readOnlyDiagnostic("delete", "tx template"))
+		return
+	}
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testTxTemplate(units string) *TxTemplateResourceModel {
+	return &TxTemplateResourceModel{
+		TxTemplateId:  NewUUIDUnknown(),
+		BaseCode:      types.StringValue("DEPOSIT"),
+		CodeSuffix:    types.StringValue(txTemplateCodeSuffixHash),
+		CurrentCode:   types.StringUnknown(),
+		PreviousCodes: types.ListUnknown(types.StringType),
+		Transaction: &TxTemplateTransactionModel{
+			Effective: types.StringValue("date()"),
+			JournalId: types.StringValue("'b9a5ea5c-6ba6-4a29-a0e6-8b8ea7a64e5c'"),
+		},
+		Entries: []TxTemplateEntryModel{{
+			EntryType: types.StringValue("'DEPOSIT_DR'"),
+			AccountId: types.StringValue("'00000000-0000-0000-0000-000000000001'"),
+			Layer:     types.StringValue("SETTLED"),
+			Direction: types.StringValue("DEBIT"),
+			Units:     types.StringValue(units),
+			Currency:  types.StringValue("'USD'"),
+		}},
+		Version: types.Int64Unknown(),
+	}
+}
+
+// planTxTemplate derives the code of plan the way ModifyPlan does.
+func planTxTemplate(t *testing.T, plan, state *TxTemplateResourceModel) {
+	t.Helper()
+
+	hash, known := txTemplateContentHash(plan)
+	if !known {
+		t.Fatal("expected the content hash to be known")
+	}
+
+	code, previousCodes := txTemplateCode(plan, state, hash)
+
+	plan.TxTemplateId = NewUUIDValue(uuid.NewSHA1(txTemplateIdNamespace, []byte(code)).String())
+	plan.Code = types.StringValue(code)
+	plan.CurrentCode = plan.Code
+	plan.PreviousCodes = types.ListValueMust(types.StringType, previousCodes)
+}
+
+func TestTxTemplateCodeRoundTrip(t *testing.T) {
+	first := testTxTemplate("decimal('1')")
+	planTxTemplate(t, first, nil)
+	first.Version = types.Int64Value(1)
+
+	second := testTxTemplate("decimal('2')")
+	planTxTemplate(t, second, first)
+	second.Version = types.Int64Value(1)
+
+	if second.Code.Equal(first.Code) {
+		t.Fatalf("expected changed content to derive a new code, got %s", second.Code)
+	}
+
+	if !second.PreviousCodes.Equal(types.ListValueMust(types.StringType, []attr.Value{first.Code})) {
+		t.Fatalf("expected %s to be the previous code, got %s", first.Code, second.PreviousCodes)
+	}
+
+	reverted := testTxTemplate("decimal('1')")
+	planTxTemplate(t, reverted, second)
+
+	if !reverted.Code.Equal(first.Code) || !reverted.TxTemplateId.Equal(first.TxTemplateId) {
+		t.Fatalf("expected reverted content to derive %s (%s), got %s (%s)", first.Code, first.TxTemplateId, reverted.Code, reverted.TxTemplateId)
+	}
+
+	if !reverted.PreviousCodes.Equal(types.ListValueMust(types.StringType, []attr.Value{second.Code})) {
+		t.Fatalf("expected %s to be the only previous code, got %s", second.Code, reverted.PreviousCodes)
+	}
+
+	// The reverted template already exists, Update must not post it again.
+	client := &fakeClient{responses: map[string]string{
+		"txTemplateGet": `{"txTemplate": {"txTemplateId": "` + first.TxTemplateId.ValueString() + `", "code": "` + first.Code.ValueString() + `", "version": 1}}`,
+	}}
+	var graphqlClient graphql.Client = client
+	r := &TxTemplateResource{client: &graphqlClient}
+
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	schema := schemaResp.Schema
+	null := tftypes.NewValue(schema.Type().TerraformType(ctx), nil)
+
+	req := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: schema, Raw: null},
+		State: tfsdk.State{Schema: schema, Raw: null},
+	}
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: schema, Raw: null}}
+
+	diags := req.Plan.Set(ctx, reverted)
+	diags.Append(req.State.Set(ctx, second)...)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	r.Update(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	if !slices.Equal(client.operations, []string{"txTemplateGet"}) {
+		t.Fatalf("expected the previous template to be read, not created, got %v", client.operations)
+	}
+
+	var updated *TxTemplateResourceModel
+	if diags := resp.State.Get(ctx, &updated); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if !updated.Code.Equal(first.Code) || updated.Version.ValueInt64() != 1 {
+		t.Fatalf("expected %s version 1, got %s version %s", first.Code, updated.Code, updated.Version)
+	}
+}
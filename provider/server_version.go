@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// The range of cala server versions the schema in schema/vendor/schema.graphql
+// is known to be compatible with. Bump these whenever the vendored schema is
+// updated.
+const (
+	minServerVersion = "0.3.0"
+	maxServerVersion = "0.4.0"
+)
+
+var supportedServerVersions = fmt.Sprintf(">= %s, < %s", minServerVersion, maxServerVersion)
+
+// checkServerVersion compares the version reported by the cala server against
+// the supported range. Pre-release and build suffixes are ignored. Servers older
+// than the supported range are an error as the provider queries fields they do
+// not know about, newer servers only produce a warning.
+func checkServerVersion(serverVersion string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	v, err := version.NewVersion(serverVersion)
+	if err != nil {
+		diags.AddWarning(
+			"Unrecognized Server Version",
+			fmt.Sprintf("The cala server reported version %q which could not be parsed (%s). "+
+				"Compatibility with this provider (%s) could not be verified.", serverVersion, err, supportedServerVersions),
+		)
+		return diags
+	}
+
+	if v.Core().LessThan(version.Must(version.NewVersion(minServerVersion))) {
+		diags.AddError(
+			"Unsupported Server Version",
+			fmt.Sprintf("The cala server is running version %s but this provider requires %s. "+
+				"Upgrade the server or use an older release of the provider. "+
+				"Set skip_version_check = true in the provider block to bypass this check.", serverVersion, supportedServerVersions),
+		)
+		return diags
+	}
+
+	if !v.Core().LessThan(version.Must(version.NewVersion(maxServerVersion))) {
+		diags.AddWarning(
+			"Untested Server Version",
+			fmt.Sprintf("The cala server is running version %s which is newer than the versions this provider was built against (%s). "+
+				"Some operations may fail if the server schema changed in an incompatible way.", serverVersion, supportedServerVersions),
+		)
+	}
+
+	return diags
+}
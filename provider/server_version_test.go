@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestCheckServerVersion(t *testing.T) {
+	cases := []struct {
+		serverVersion string
+		severity      diag.Severity
+		summary       string
+	}{
+		{minServerVersion, diag.SeverityInvalid, ""},
+		{"v" + minServerVersion, diag.SeverityInvalid, ""},
+		{minServerVersion + "-rc.1", diag.SeverityInvalid, ""},
+		{minServerVersion + "+build.7", diag.SeverityInvalid, ""},
+		{"0.3.12", diag.SeverityInvalid, ""},
+		{"0.2.9", diag.SeverityError, "Unsupported Server Version"},
+		{"0.0.1", diag.SeverityError, "Unsupported Server Version"},
+		{maxServerVersion, diag.SeverityWarning, "Untested Server Version"},
+		{maxServerVersion + "-alpha", diag.SeverityWarning, "Untested Server Version"},
+		{"1.0.0", diag.SeverityWarning, "Untested Server Version"},
+		{"not-a-version", diag.SeverityWarning, "Unrecognized Server Version"},
+		{"", diag.SeverityWarning, "Unrecognized Server Version"},
+	}
+
+	for _, c := range cases {
+		diags := checkServerVersion(c.serverVersion)
+
+		if c.summary == "" {
+			if len(diags) != 0 {
+				t.Errorf("%q: expected no diagnostics, got %v", c.serverVersion, diags)
+			}
+			continue
+		}
+
+		if len(diags) != 1 {
+			t.Errorf("%q: expected one diagnostic, got %v", c.serverVersion, diags)
+			continue
+		}
+
+		if diags[0].Severity() != c.severity || diags[0].Summary() != c.summary {
+			t.Errorf("%q: expected %s %q, got %s %q", c.serverVersion, c.severity, c.summary, diags[0].Severity(), diags[0].Summary())
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable     = CurrencyCodeType{}
+	_ xattr.ValidateableAttribute = CurrencyCodeValue{}
+)
+
+// CurrencyCodeType is the type of attributes holding a cala CurrencyCode
+// scalar. Values must be ISO 4217 codes or known crypto currency codes, see
+// validateCurrencyCode.
+type CurrencyCodeType struct {
+	basetypes.StringType
+}
+
+func (t CurrencyCodeType) String() string {
+	return "CurrencyCodeType"
+}
+
+func (t CurrencyCodeType) ValueType(ctx context.Context) attr.Value {
+	return CurrencyCodeValue{}
+}
+
+func (t CurrencyCodeType) Equal(o attr.Type) bool {
+	other, ok := o.(CurrencyCodeType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t CurrencyCodeType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return CurrencyCodeValue{StringValue: in}, nil
+}
+
+func (t CurrencyCodeType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return CurrencyCodeValue{StringValue: stringValue}, nil
+}
+
+type CurrencyCodeValue struct {
+	basetypes.StringValue
+}
+
+func NewCurrencyCodeValue(value string) CurrencyCodeValue {
+	return CurrencyCodeValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func NewCurrencyCodePointerValue(value *string) CurrencyCodeValue {
+	return CurrencyCodeValue{StringValue: basetypes.NewStringPointerValue(value)}
+}
+
+func NewCurrencyCodeNull() CurrencyCodeValue {
+	return CurrencyCodeValue{StringValue: basetypes.NewStringNull()}
+}
+
+func NewCurrencyCodeUnknown() CurrencyCodeValue {
+	return CurrencyCodeValue{StringValue: basetypes.NewStringUnknown()}
+}
+
+func (v CurrencyCodeValue) Type(ctx context.Context) attr.Type {
+	return CurrencyCodeType{}
+}
+
+func (v CurrencyCodeValue) Equal(o attr.Value) bool {
+	other, ok := o.(CurrencyCodeValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v CurrencyCodeValue) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if err := validateCurrencyCode(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Currency Code", err.Error())
+	}
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	_ basetypes.StringTypable                    = DecimalType{}
+	_ basetypes.StringValuableWithSemanticEquals = DecimalValue{}
+	_ xattr.ValidateableAttribute                = DecimalValue{}
+)
+
+// DecimalType is the type of attributes holding a cala Decimal scalar. Values
+// are compared numerically, so "1.0" and "1" are equal.
+type DecimalType struct {
+	basetypes.StringType
+}
+
+func (t DecimalType) String() string {
+	return "DecimalType"
+}
+
+func (t DecimalType) ValueType(ctx context.Context) attr.Value {
+	return DecimalValue{}
+}
+
+func (t DecimalType) Equal(o attr.Type) bool {
+	other, ok := o.(DecimalType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t DecimalType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return DecimalValue{StringValue: in}, nil
+}
+
+func (t DecimalType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return DecimalValue{StringValue: stringValue}, nil
+}
+
+type DecimalValue struct {
+	basetypes.StringValue
+}
+
+func NewDecimalValue(value string) DecimalValue {
+	return DecimalValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func NewDecimalPointerValue(value *string) DecimalValue {
+	return DecimalValue{StringValue: basetypes.NewStringPointerValue(value)}
+}
+
+func NewDecimalNull() DecimalValue {
+	return DecimalValue{StringValue: basetypes.NewStringNull()}
+}
+
+func NewDecimalUnknown() DecimalValue {
+	return DecimalValue{StringValue: basetypes.NewStringUnknown()}
+}
+
+func (v DecimalValue) Type(ctx context.Context) attr.Type {
+	return DecimalType{}
+}
+
+func (v DecimalValue) Equal(o attr.Value) bool {
+	other, ok := o.(DecimalValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v DecimalValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(DecimalValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+
+		return false, diags
+	}
+
+	// Both values passed validation, unparsable values are never equal.
+	prior, err := decimal.NewFromString(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	proposed, err := decimal.NewFromString(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return prior.Equal(proposed), diags
+}
+
+func (v DecimalValue) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, err := decimal.NewFromString(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Decimal", fmt.Sprintf("%q is not a decimal number.", v.ValueString()))
+	}
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDecimalSemanticEquals(t *testing.T) {
+	cases := []struct {
+		prior, proposed string
+		equal           bool
+	}{
+		{"1.5", "1.5", true},
+		{"1.5", "1.50", true},
+		{"100", "100.000", true},
+		{"0", "-0.0", true},
+		{"1e3", "1000", true},
+		{"0.1", "0.10000000000000000001", false},
+		{"1.5", "-1.5", false},
+		{"1.5", "not a decimal", false},
+	}
+
+	for _, c := range cases {
+		equal, diags := NewDecimalValue(c.prior).StringSemanticEquals(context.Background(), NewDecimalValue(c.proposed))
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+
+		if equal != c.equal {
+			t.Errorf("%s and %s: expected semantic equality to be %t", c.prior, c.proposed, c.equal)
+		}
+	}
+
+	if _, diags := NewDecimalValue("1").StringSemanticEquals(context.Background(), NewTimestampValue("2024-01-31T12:00:00Z")); !diags.HasError() {
+		t.Error("expected an error comparing a decimal with another type")
+	}
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = TimestampType{}
+	_ basetypes.StringValuableWithSemanticEquals = TimestampValue{}
+	_ xattr.ValidateableAttribute                = TimestampValue{}
+)
+
+// TimestampType is the type of attributes holding a cala Timestamp scalar,
+// an RFC 3339 date and time. Values are compared as instants, so the same
+// time given in different time zones is equal.
+type TimestampType struct {
+	basetypes.StringType
+}
+
+func (t TimestampType) String() string {
+	return "TimestampType"
+}
+
+func (t TimestampType) ValueType(ctx context.Context) attr.Value {
+	return TimestampValue{}
+}
+
+func (t TimestampType) Equal(o attr.Type) bool {
+	other, ok := o.(TimestampType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t TimestampType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return TimestampValue{StringValue: in}, nil
+}
+
+func (t TimestampType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return TimestampValue{StringValue: stringValue}, nil
+}
+
+type TimestampValue struct {
+	basetypes.StringValue
+}
+
+func NewTimestampValue(value string) TimestampValue {
+	return TimestampValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func NewTimestampPointerValue(value *string) TimestampValue {
+	return TimestampValue{StringValue: basetypes.NewStringPointerValue(value)}
+}
+
+func NewTimestampNull() TimestampValue {
+	return TimestampValue{StringValue: basetypes.NewStringNull()}
+}
+
+func NewTimestampUnknown() TimestampValue {
+	return TimestampValue{StringValue: basetypes.NewStringUnknown()}
+}
+
+func (v TimestampValue) Type(ctx context.Context) attr.Type {
+	return TimestampType{}
+}
+
+func (v TimestampValue) Equal(o attr.Value) bool {
+	other, ok := o.(TimestampValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v TimestampValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(TimestampValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+
+		return false, diags
+	}
+
+	prior, err := time.Parse(time.RFC3339Nano, v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	proposed, err := time.Parse(time.RFC3339Nano, newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return prior.Equal(proposed), diags
+}
+
+func (v TimestampValue) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339Nano, v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Timestamp", fmt.Sprintf("%q is not an RFC 3339 timestamp such as 2024-01-31T12:00:00Z.", v.ValueString()))
+	}
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTimestampSemanticEquals(t *testing.T) {
+	cases := []struct {
+		prior, proposed string
+		equal           bool
+	}{
+		{"2024-01-31T12:00:00Z", "2024-01-31T12:00:00Z", true},
+		{"2024-01-31T12:00:00Z", "2024-01-31T12:00:00.000Z", true},
+		{"2024-01-31T12:00:00Z", "2024-01-31T14:00:00+02:00", true},
+		{"2024-01-31T12:00:00.123456Z", "2024-01-31T12:00:00.123456000Z", true},
+		{"2024-01-31T12:00:00Z", "2024-01-31T12:00:00.000001Z", false},
+		{"2024-01-31T12:00:00Z", "2024-01-31T12:00:00+02:00", false},
+		{"2024-01-31T12:00:00Z", "2024-01-31", false},
+	}
+
+	for _, c := range cases {
+		equal, diags := NewTimestampValue(c.prior).StringSemanticEquals(context.Background(), NewTimestampValue(c.proposed))
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+
+		if equal != c.equal {
+			t.Errorf("%s and %s: expected semantic equality to be %t", c.prior, c.proposed, c.equal)
+		}
+	}
+
+	if _, diags := NewTimestampValue("2024-01-31T12:00:00Z").StringSemanticEquals(context.Background(), NewUUIDValue("b9a5ea5c-6ba6-4a29-a0e6-8b8ea7a64e5c")); !diags.HasError() {
+		t.Error("expected an error comparing a timestamp with another type")
+	}
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = UUIDType{}
+	_ basetypes.StringValuableWithSemanticEquals = UUIDValue{}
+	_ xattr.ValidateableAttribute                = UUIDValue{}
+)
+
+// UUIDType is the type of attributes holding a cala UUID scalar. Values are
+// compared case-insensitively, cala returns UUIDs in lower case.
+type UUIDType struct {
+	basetypes.StringType
+}
+
+func (t UUIDType) String() string {
+	return "UUIDType"
+}
+
+func (t UUIDType) ValueType(ctx context.Context) attr.Value {
+	return UUIDValue{}
+}
+
+func (t UUIDType) Equal(o attr.Type) bool {
+	other, ok := o.(UUIDType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t UUIDType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return UUIDValue{StringValue: in}, nil
+}
+
+func (t UUIDType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return UUIDValue{StringValue: stringValue}, nil
+}
+
+type UUIDValue struct {
+	basetypes.StringValue
+}
+
+func NewUUIDValue(value string) UUIDValue {
+	return UUIDValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func NewUUIDPointerValue(value *string) UUIDValue {
+	return UUIDValue{StringValue: basetypes.NewStringPointerValue(value)}
+}
+
+func NewUUIDNull() UUIDValue {
+	return UUIDValue{StringValue: basetypes.NewStringNull()}
+}
+
+func NewUUIDUnknown() UUIDValue {
+	return UUIDValue{StringValue: basetypes.NewStringUnknown()}
+}
+
+func (v UUIDValue) Type(ctx context.Context) attr.Type {
+	return UUIDType{}
+}
+
+func (v UUIDValue) Equal(o attr.Value) bool {
+	other, ok := o.(UUIDValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v UUIDValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(UUIDValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+
+		return false, diags
+	}
+
+	return strings.EqualFold(v.ValueString(), newValue.ValueString()), diags
+}
+
+func (v UUIDValue) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if err := validateUUID(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid UUID", err.Error())
+	}
+}
+
+// validateUUID only accepts the hyphenated form, the other forms understood
+// by uuid.Parse are rejected by cala.
+func validateUUID(value string) error {
+	if _, err := uuid.Parse(value); err != nil || len(value) != 36 {
+		return fmt.Errorf("%q is not a UUID in the form xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx", value)
+	}
+
+	return nil
+}
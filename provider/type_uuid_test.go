@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestUUIDSemanticEquals(t *testing.T) {
+	cases := []struct {
+		prior, proposed string
+		equal           bool
+	}{
+		{"b9a5ea5c-6ba6-4a29-a0e6-8b8ea7a64e5c", "b9a5ea5c-6ba6-4a29-a0e6-8b8ea7a64e5c", true},
+		{"b9a5ea5c-6ba6-4a29-a0e6-8b8ea7a64e5c", "B9A5EA5C-6BA6-4A29-A0E6-8B8EA7A64E5C", true},
+		{"b9a5ea5c-6ba6-4a29-a0e6-8b8ea7a64e5c", "b9a5ea5c-6ba6-4a29-a0e6-8b8ea7a64e5d", false},
+		{"b9a5ea5c-6ba6-4a29-a0e6-8b8ea7a64e5c", "b9a5ea5c6ba64a29a0e68b8ea7a64e5c", false},
+	}
+
+	for _, c := range cases {
+		equal, diags := NewUUIDValue(c.prior).StringSemanticEquals(context.Background(), NewUUIDValue(c.proposed))
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+
+		if equal != c.equal {
+			t.Errorf("%s and %s: expected semantic equality to be %t", c.prior, c.proposed, c.equal)
+		}
+	}
+
+	if _, diags := NewUUIDValue("b9a5ea5c-6ba6-4a29-a0e6-8b8ea7a64e5c").StringSemanticEquals(context.Background(), NewDecimalValue("1")); !diags.HasError() {
+		t.Error("expected an error comparing a UUID with another type")
+	}
+}